@@ -0,0 +1,111 @@
+package builder
+
+import (
+	"strconv"
+	"strings"
+)
+
+// directivePrefix ile başlayan anahtarlar HTML'e yazılmaz; render metodlarına davranış bildirmek için kullanılır.
+const directivePrefix = "fb:"
+
+const (
+	submitDisabledDirective  = directivePrefix + "submit-disabled"
+	inlineDirective          = directivePrefix + "inline"
+	precisionDirective       = directivePrefix + "precision"
+	disabledOptionsDirective = directivePrefix + "disabled-options"
+)
+
+// Disabled, alanı devre dışı bırakır. Devre dışı alanlar form ile gönderilmez.
+func Disabled() Attr { return Attr{"disabled": ""} }
+
+// DisabledWithValue, alanı devre dışı bırakır ve değerin yine de gönderilmesi için yanına gizli bir alan ekler.
+func DisabledWithValue() Attr { return Attr{"disabled": "", submitDisabledDirective: "1"} }
+
+// Readonly, alanı salt okunur yapar; değer bağlama ve hata durumu normal şekilde uygulanır.
+func Readonly() Attr { return Attr{"readonly": ""} }
+
+// Required, alanı tarayıcı tarafında zorunlu yapar; HTML5Validation açık değilken de kullanılabilir.
+func Required() Attr { return Attr{"required": ""} }
+
+// Size, select'in aynı anda göstereceği satır sayısını belirler; MultiSelect ile açılır liste yerine liste kutusu çizilir.
+func Size(rows int) Attr { return Attr{"size": strconv.Itoa(rows)} }
+
+// Inline, RadioGroup seçeneklerini alt alta yerine yan yana dizer.
+func Inline() Attr { return Attr{inlineDirective: "1"} }
+
+// Placeholder, alanın placeholder metnini ayarlar. Select ile kullanıldığında başa seçilemeyen boş bir seçenek ekler.
+func Placeholder(text string) Attr { return Attr{"placeholder": text} }
+
+// DisabledOptions, Option.Disabled'ı tek tek işaretlemek yerine verilen değerlere sahip seçenekleri seçilemez
+// yapan kısayoldur; OptionsFromMap gibi başka yerde üretilmiş seçenek listeleri için kullanışlıdır.
+func DisabledOptions(values ...string) Attr {
+	return Attr{disabledOptionsDirective: strings.Join(values, "\n")}
+}
+
+// Precision, float alanlara bağlanan değeri sabit sayıda ondalık basamakla yazar (ör. fiyatlar için 2).
+// Önceki girdiden gelen değerler olduğu gibi kalır.
+func Precision(digits int) Attr { return Attr{precisionDirective: strconv.Itoa(digits)} }
+
+// PhonePattern, telefon numaraları için gevşek bir HTML pattern ifadesidir: rakam, boşluk, +, -, ( ve ).
+const PhonePattern = `[0-9+\(\)\- ]{5,20}`
+
+// Phone, Tel alanına PhonePattern ile telefon numarası ipucu ekler.
+func Phone() Attr { return Attr{"pattern": PhonePattern, "inputmode": "tel"} }
+
+// autocompleteTokens, HTML standardındaki autocomplete alan adlarıdır.
+var autocompleteTokens = map[string]bool{
+	"off": true, "on": true, "name": true, "honorific-prefix": true, "given-name": true, "additional-name": true,
+	"family-name": true, "honorific-suffix": true, "nickname": true, "username": true, "new-password": true,
+	"current-password": true, "one-time-code": true, "organization-title": true, "organization": true,
+	"street-address": true, "address-line1": true, "address-line2": true, "address-line3": true,
+	"address-level1": true, "address-level2": true, "address-level3": true, "address-level4": true,
+	"country": true, "country-name": true, "postal-code": true, "cc-name": true, "cc-given-name": true,
+	"cc-additional-name": true, "cc-family-name": true, "cc-number": true, "cc-exp": true, "cc-exp-month": true,
+	"cc-exp-year": true, "cc-csc": true, "cc-type": true, "transaction-currency": true, "transaction-amount": true,
+	"language": true, "bday": true, "bday-day": true, "bday-month": true, "bday-year": true, "sex": true,
+	"url": true, "photo": true, "tel": true, "tel-country-code": true, "tel-national": true, "tel-area-code": true,
+	"tel-local": true, "tel-extension": true, "email": true, "impp": true,
+}
+
+// autocompleteModifiers, alan adından önce gelebilen bölüm ve iletişim türü belirteçleridir.
+var autocompleteModifiers = map[string]bool{
+	"shipping": true, "billing": true, "home": true, "work": true, "mobile": true, "fax": true, "pager": true,
+}
+
+// Autocomplete, alana autocomplete niteliği ekler. "shipping street-address" gibi belirteç dizileri ve
+// "section-" önekleri kabul edilir; tanınmayan değerler yok sayılır.
+func Autocomplete(token string) Attr {
+	fields := strings.Fields(strings.ToLower(token))
+	if len(fields) == 0 {
+		return Attr{}
+	}
+	last := len(fields) - 1
+	if fields[last] == "webauthn" && last > 0 {
+		last--
+	}
+	if !autocompleteTokens[fields[last]] {
+		return Attr{}
+	}
+	if (fields[last] == "on" || fields[last] == "off") && len(fields) > 1 {
+		return Attr{}
+	}
+	for i, f := range fields[:last] {
+		if !autocompleteModifiers[f] && !(i == 0 && strings.HasPrefix(f, "section-")) {
+			return Attr{}
+		}
+	}
+	return Attr{"autocomplete": strings.Join(fields, " ")}
+}
+
+var inputModes = map[string]bool{
+	"none": true, "text": true, "decimal": true, "numeric": true, "tel": true, "search": true, "email": true, "url": true,
+}
+
+// InputMode, mobil cihazlarda gösterilecek klavye türünü belirler; tanınmayan değerler yok sayılır.
+func InputMode(mode string) Attr {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	if !inputModes[mode] {
+		return Attr{}
+	}
+	return Attr{"inputmode": mode}
+}
@@ -0,0 +1,178 @@
+package builder
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// autoField, Auto tarafından modelden çıkarılan tek bir alanın tanımıdır.
+type autoField struct {
+	name        string
+	label       string
+	widget      string
+	placeholder string
+}
+
+// Auto, modelin dışa açık her alanı için etiketli bir form alanı üretir. Input tipi alanın Go tipinden
+// seçilir; `label`, `placeholder` ve `type` etiketleriyle değiştirilebilir. `form:"-"` alanları atlanır.
+func (b *Builder) Auto() template.HTML {
+	var html strings.Builder
+	for _, field := range modelFields(b.model) {
+		html.WriteString(string(b.autoField(field)))
+	}
+	return template.HTML(html.String())
+}
+
+// Render, basit CRUD sayfaları için formun tamamını tek seferde yazar: Open, Auto alanları, Config.SubmitLabel
+// metinli gönder butonu ve Close.
+func (b *Builder) Render(w io.Writer) error {
+	if err := b.WriteOpen(w); err != nil {
+		return err
+	}
+	hw := &htmlWriter{w: w}
+	for _, field := range modelFields(b.model) {
+		hw.str(string(b.autoField(field)))
+	}
+	label := b.submitLabel
+	if label == "" {
+		label = "Submit"
+	}
+	hw.str(string(b.Submit(label)))
+	if hw.err != nil {
+		return hw.err
+	}
+	return b.WriteClose(w)
+}
+
+func (b *Builder) autoField(field autoField) template.HTML {
+	attrs := Attr{}
+	if field.placeholder != "" {
+		attrs["placeholder"] = field.placeholder
+	}
+	switch field.widget {
+	case "hidden":
+		return b.Hidden(field.name, attrs)
+	case "checkbox":
+		label := fmt.Sprintf(`<label class="%s" for="%s">%s</label>`, b.theme.CheckLabel, template.HTMLEscapeString(b.ID(field.name+"_1")), template.HTMLEscapeString(b.translate(field.label)))
+		check := template.HTML(fmt.Sprintf(`<div class="%s">`, b.theme.CheckWrapper)) +
+			b.Checkbox(field.name, "1", attrs) + template.HTML(label) + `</div>` + b.FieldError(field.name)
+		if class := b.groupWrapperClass(); class != "" {
+			return template.HTML(fmt.Sprintf(`<div class="%s">`, template.HTMLEscapeString(class))) + check + `</div>`
+		}
+		return check
+	case "textarea":
+		return b.Group(field.name, field.label, b.Textarea(field.name, 0, 0, attrs))
+	case "color":
+		return b.Group(field.name, field.label, b.Color(field.name, attrs))
+	default:
+		return b.Group(field.name, field.label, b.Input(field.widget, field.name, attrs))
+	}
+}
+
+func modelFields(model interface{}) []autoField {
+	typ := reflect.TypeOf(model)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+	return structFields(typ)
+}
+
+// structFields, struct'ın alanlarını bildirim sırasıyla çıkarır. Gömülü struct'ların alanları yerinde açılır;
+// Go'daki gölgeleme kuralına uygun olarak aynı adlı bir doğrudan alan varsa gömülü olan atlanır.
+func structFields(typ reflect.Type) []autoField {
+	// Gömülü bir struct, o noktada açılacak alanlarını promoted içinde taşıyan bir girdi olarak tutulur.
+	type entry struct {
+		field    autoField
+		embedded bool
+		promoted []autoField
+	}
+	var entries []entry
+	direct := make(map[string]bool)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if embedded, ok := embeddedStruct(field); ok {
+			entries = append(entries, entry{embedded: true, promoted: structFields(embedded)})
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("form"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = strings.Split(field.Tag.Get("json"), ",")[0]
+		}
+		if name == "" || name == "-" {
+			name = field.Name
+		}
+		widget := field.Tag.Get("type")
+		if widget == "" {
+			widget = widgetFor(field.Type)
+		}
+		if widget == "" {
+			continue
+		}
+		label := field.Tag.Get("label")
+		if label == "" {
+			label = humanize(name)
+		}
+		direct[name] = true
+		entries = append(entries, entry{field: autoField{name: name, label: label, widget: widget, placeholder: field.Tag.Get("placeholder")}})
+	}
+	var fields []autoField
+	for _, e := range entries {
+		if !e.embedded {
+			fields = append(fields, e.field)
+			continue
+		}
+		for _, inner := range e.promoted {
+			if !direct[inner.name] {
+				fields = append(fields, inner)
+			}
+		}
+	}
+	return fields
+}
+
+// modelHasFile, modelde `type:"file"` ile işaretlenmiş bir alan olup olmadığını bildirir; böyle modellerle
+// oluşturulan formlar multipart olarak açılır.
+func modelHasFile(model interface{}) bool {
+	for _, field := range modelFields(model) {
+		if field.widget == "file" {
+			return true
+		}
+	}
+	return false
+}
+
+// widgetFor, Go tipine göre varsayılan input tipini seçer; desteklenmeyen tipler için boş döner.
+func widgetFor(typ reflect.Type) string {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == timeType {
+		return "date"
+	}
+	switch typ.Kind() {
+	case reflect.Bool:
+		return "checkbox"
+	case reflect.String:
+		return "text"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	}
+	return ""
+}
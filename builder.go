@@ -1,34 +1,149 @@
 package builder
 
 import (
+	"context"
 	"html/template"
 	"net/url"
 )
 
 // Builder, bir HTML formu oluşturmak için gereken tüm durumu ve metodları içerir.
 type Builder struct {
-	model       interface{}
-	oldInput    url.Values
-	errors      map[string]string
-	csrfToken   string
-	csrfField   string
-	action      string
-	method      string
-	isMultipart bool
+	model        interface{}
+	oldInput     url.Values
+	errors       map[string]string
+	fieldErrors  map[string][]string
+	valid        map[string]bool
+	help         map[string]string
+	inferValid   bool
+	csrfToken    string
+	csrfField    string
+	methodField  string
+	action       string
+	excludeQuery []string
+	method       string
+	isMultipart  bool
+	theme        *Theme
+	nonce        string
+
+	autoPlaceholder  bool
+	html5Validation  bool
+	translator       func(key string) string
+	translateErrors  bool
+	errorKeyStyle    ErrorKeyStyle
+	templates        map[string]*template.Template
+	globalErrors     []string
+	hiddenFields     [][2]string
+	clientValidation bool
+	ctx              context.Context
+	ctxTranslator    func(ctx context.Context, key string) string
+	idPrefix         string
+	omitEmptyValue   bool
+	step             int
+	trackChanges     bool
+	enctype          string
+	groupClass       *string
+	layout           Layout
+	formID           string
+	formAttribute    bool
+	submitLabel      string
 }
 
 // Config, yeni bir Builder oluşturmak için gerekli verileri taşır.
 type Config struct {
-	Action    string
-	Method    string
-	CSRFToken string
-	CSRFField string
-	Model     interface{}
-	OldInput  url.Values
-	Errors    map[string]string
+	Action string
+	// ExcludeQuery, GET formlarında action sorgu dizesinden gizli alan olarak taşınmayacak anahtarlardır.
+	ExcludeQuery []string
+	Method       string
+	CSRFToken    string
+	CSRFField    string
+	MethodField  string
+	Model        interface{}
+	OldInput     url.Values
+	Errors       map[string]string
+	FieldErrors  map[string][]string
+	// Valid, doğrulamadan geçen alanları işaretler. InferValid açıkken eski girdisi olup hatası olmayan alanlar da geçerli sayılır.
+	Valid      map[string]bool
+	InferValid bool
+	// Help, alanların yardım metinleridir. Burada tanımlanan alanların input'ları aria-describedby ile yardım metnine bağlanır.
+	Help      map[string]string
 	Multipart bool
+	Theme     *Theme
+	// Nonce, builder'ın ürettiği <script>/<style> etiketlerine eklenen CSP nonce değeridir. Yalnızca base64 karakterleri kabul edilir.
+	Nonce string
+	// AutoPlaceholder, placeholder verilmeyen metin alanlarına alan adından türetilen bir placeholder ekler.
+	AutoPlaceholder bool
+	// HTML5Validation, validate etiketlerindeki kuralları maxlength, min, max gibi yerel HTML5 niteliklerine çevirir.
+	HTML5Validation bool
+	// Translator, etiket, buton, placeholder ve yardım metinlerini render edilmeden önce çevirir.
+	// TranslateErrors açıkken Errors içindeki mesajlar da çeviri anahtarı olarak ele alınır.
+	Translator      func(key string) string
+	TranslateErrors bool
+	// ErrorKeyStyle, Errors ve FieldErrors anahtarlarının form adına mı Go alan adına mı göre aranacağını belirler.
+	ErrorKeyStyle ErrorKeyStyle
+	// Templates, widget adına ("text", "select", "label"...) göre yerleşik HTML yerine kullanılacak şablonlardır.
+	// Şablonlar WidgetContext ile çalıştırılır; tanımlı olmayan widget'lar yerleşik HTML ile render edilir.
+	Templates map[string]*template.Template
+	// GlobalErrors, herhangi bir alana bağlı olmayan form düzeyindeki hatalardır; ErrorSummary'de en üstte listelenir.
+	GlobalErrors []string
+	// ClientValidation, validate etiketlerini jQuery Validate ve Parsley gibi eklentilerin okuduğu
+	// data-rule-* ve data-msg-* niteliklerine çevirir.
+	ClientValidation bool
+	// ContextTranslator, Translator'ın WithContext ile bağlanan istek context'ini de alan biçimidir; kullanıcının
+	// diline göre çeviri yapmak içindir. İkisi birden verilirse ContextTranslator kullanılır.
+	ContextTranslator func(ctx context.Context, key string) string
+	// IDPrefix, üretilen tüm id'lerin başına eklenir; aynı sayfadaki birden çok formun id'lerinin çakışmasını önler.
+	IDPrefix string
+	// OmitEmptyValue, çözülen değeri boş olan input'larda value="" yazmak yerine value niteliğini hiç yazmaz.
+	// Elle verilen value niteliklerine dokunulmaz.
+	OmitEmptyValue bool
+	// Step, çok adımlı formlarda render edilen adımdır; verilmezse gönderilen _step alanından okunur.
+	Step int
+	// TrackChanges, input, textarea ve select alanlarının yanına modeldeki ilk değeri taşıyan gizli
+	// original[name] alanlarını ekler; sunucuda DiffChanges ile yalnızca değişen alanlar bulunur.
+	TrackChanges bool
+	// Enctype, form etiketinin enctype değerini açıkça belirler ve Multipart'tan önceliklidir; boşsa Multipart
+	// açık olduğunda multipart/form-data kullanılır. GET ile multipart birlikte istendiğinde form POST olarak açılır.
+	Enctype string
+	// GroupClass, Group sarmalayıcısının sınıfını temadakinin yerine geçirir (ör. "col-md-6 mb-3"). nil ise tema
+	// kullanılır; boş string verilirse sarmalayıcı div hiç yazılmaz. Değer Class ile verilebilir.
+	GroupClass *string
+	// Layout, Group ve Label'ın düzenini belirler: dikey (varsayılan), yatay ya da satır içi.
+	Layout Layout
+	// FormID, Open'ın yazdığı <form> etiketinin id'sidir. FormAttribute açıksa builder'ın ürettiği alan ve
+	// butonlara form="FormID" eklenir; böylece form etiketinin dışında render edilseler de forma gönderilirler.
+	FormID        string
+	FormAttribute bool
+	// SubmitLabel, Render'ın yazdığı gönder butonunun metnidir; boşsa "Submit" kullanılır. Metin Translator'dan geçer.
+	SubmitLabel string
 }
 
+// ErrorKeyStyle, hata haritalarındaki anahtarların hangi ada göre eşleştirileceğini belirtir.
+type ErrorKeyStyle int
+
+const (
+	// ErrorKeyTag, hataları input'un form adıyla (ör. "email") arar. Varsayılandır.
+	ErrorKeyTag ErrorKeyStyle = iota
+	// ErrorKeyField, hataları modeldeki Go alan adıyla (ör. "Email", iç içe alanlarda "Address.City") arar.
+	ErrorKeyField
+	// ErrorKeyBoth, önce form adını sonra Go alan adını dener.
+	ErrorKeyBoth
+)
+
+// Layout, formdaki etiket ve alanların yerleşimidir.
+type Layout int
+
+const (
+	// LayoutVertical, etiketi alanın üstüne koyar. Varsayılandır.
+	LayoutVertical Layout = iota
+	// LayoutHorizontal, etiketi ve alanı aynı satırda iki grid sütununa yerleştirir.
+	LayoutHorizontal
+	// LayoutInline, alanları tek satırda yan yana dizer ve etiketleri yalnızca ekran okuyuculara bırakır.
+	LayoutInline
+)
+
+// Class, Config.GroupClass gibi işaretçi alanlar için verilen sınıfın adresini döndürür: Class("") sarmalayıcıyı kaldırır.
+func Class(class string) *string { return &class }
+
 // New, yeni bir form builder örneği oluşturur.
 func New(config Config) *Builder {
 	if config.OldInput == nil {
@@ -40,14 +155,212 @@ func New(config Config) *Builder {
 	if config.CSRFField == "" {
 		config.CSRFField = "_csrf"
 	}
+	if config.MethodField == "" {
+		config.MethodField = "_method"
+	}
+	if config.Theme == nil {
+		theme := BootstrapTheme
+		config.Theme = &theme
+	}
 	return &Builder{
-		action:      config.Action,
-		method:      config.Method,
-		csrfToken:   config.CSRFToken,
-		csrfField:   config.CSRFField,
-		model:       config.Model,
-		oldInput:    config.OldInput,
-		errors:      config.Errors,
-		isMultipart: config.Multipart,
-	}
-}
\ No newline at end of file
+		action:       config.Action,
+		excludeQuery: config.ExcludeQuery,
+		method:       config.Method,
+		csrfToken:    config.CSRFToken,
+		csrfField:    config.CSRFField,
+		methodField:  config.MethodField,
+		model:        config.Model,
+		oldInput:     config.OldInput,
+		errors:       config.Errors,
+		fieldErrors:  config.FieldErrors,
+		valid:        config.Valid,
+		help:         config.Help,
+		inferValid:   config.InferValid,
+		isMultipart:  config.Multipart || modelHasFile(config.Model),
+		theme:        config.Theme,
+		nonce:        sanitizeNonce(config.Nonce),
+
+		autoPlaceholder:  config.AutoPlaceholder,
+		html5Validation:  config.HTML5Validation,
+		translator:       config.Translator,
+		translateErrors:  config.TranslateErrors,
+		errorKeyStyle:    config.ErrorKeyStyle,
+		templates:        config.Templates,
+		globalErrors:     config.GlobalErrors,
+		clientValidation: config.ClientValidation,
+		ctxTranslator:    config.ContextTranslator,
+		idPrefix:         config.IDPrefix,
+		omitEmptyValue:   config.OmitEmptyValue,
+		step:             config.Step,
+		trackChanges:     config.TrackChanges,
+		enctype:          config.Enctype,
+		groupClass:       config.GroupClass,
+		layout:           config.Layout,
+		formID:           config.FormID,
+		formAttribute:    config.FormAttribute,
+		submitLabel:      config.SubmitLabel,
+	}
+}
+
+// Clone, builder'ın yüzeysel bir kopyasını döndürür. Başlangıçta yapılandırılan bir builder'ı
+// paylaşılan durumu değiştirmeden her istek için özelleştirmeye yarar.
+func (b *Builder) Clone() *Builder {
+	clone := *b
+	clone.hiddenFields = append([][2]string(nil), b.hiddenFields...)
+	return &clone
+}
+
+// WithErrors, hata haritası değiştirilmiş bir kopya döndürür.
+func (b *Builder) WithErrors(errors map[string]string) *Builder {
+	clone := b.Clone()
+	if errors == nil {
+		errors = make(map[string]string)
+	}
+	clone.errors = errors
+	return clone
+}
+
+// WithOldInput, eski girdisi değiştirilmiş bir kopya döndürür.
+func (b *Builder) WithOldInput(oldInput url.Values) *Builder {
+	clone := b.Clone()
+	if oldInput == nil {
+		oldInput = make(url.Values)
+	}
+	clone.oldInput = oldInput
+	return clone
+}
+
+// WithModel, bağlı modeli değiştirilmiş bir kopya döndürür.
+func (b *Builder) WithModel(model interface{}) *Builder {
+	clone := b.Clone()
+	clone.model = model
+	clone.isMultipart = clone.isMultipart || modelHasFile(model)
+	return clone
+}
+
+// WithCSRF, CSRF token'ı değiştirilmiş bir kopya döndürür.
+func (b *Builder) WithCSRF(token string) *Builder {
+	clone := b.Clone()
+	clone.csrfToken = token
+	return clone
+}
+
+// Nonce, builder'a verilen CSP nonce değerini döndürür. Config.Nonce boşsa bağlı context'teki nonce
+// kullanılır; geçersizse boş döner.
+func (b *Builder) Nonce() string {
+	if b.nonce == "" && b.ctx != nil {
+		nonce, _ := b.ctx.Value(nonceContextKey{}).(string)
+		return sanitizeNonce(nonce)
+	}
+	return b.nonce
+}
+
+// FormOption, NewForm ile oluşturulan formun Config değerlerini değiştirir.
+type FormOption func(*Config)
+
+// NewForm, Config yerine fonksiyonel seçeneklerle yeni bir builder oluşturur. Seçenekler sırayla uygulanır.
+func NewForm(action, method string, opts ...FormOption) *Builder {
+	config := Config{Action: action, Method: method}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return New(config)
+}
+
+// CSRFOption, formun CSRF belirtecini ayarlar.
+func CSRFOption(token string) FormOption { return func(c *Config) { c.CSRFToken = token } }
+
+// ModelOption, alan değerlerinin okunacağı modeli ayarlar.
+func ModelOption(model interface{}) FormOption { return func(c *Config) { c.Model = model } }
+
+// ErrorsOption, alan adına göre doğrulama hatalarını ayarlar.
+func ErrorsOption(errors map[string]string) FormOption { return func(c *Config) { c.Errors = errors } }
+
+// OldInputOption, önceki gönderimden gelen eski girdiyi ayarlar.
+func OldInputOption(oldInput url.Values) FormOption { return func(c *Config) { c.OldInput = oldInput } }
+
+// MultipartOption, formu dosya yüklemeleri için multipart/form-data olarak işaretler.
+func MultipartOption() FormOption { return func(c *Config) { c.Multipart = true } }
+
+// FuncMap, builder metodlarını html/template içinde doğrudan çağrılabilecek fonksiyonlar olarak döndürür.
+func (b *Builder) FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"formOpen":          b.Open,
+		"formClose":         b.Close,
+		"formLabel":         b.Label,
+		"formInput":         b.Input,
+		"formInputFor":      b.InputFor,
+		"formInputValue":    b.InputValue,
+		"formText":          b.Text,
+		"formEmail":         b.Email,
+		"formPassword":      b.Password,
+		"formURL":           b.URL,
+		"formTel":           b.Tel,
+		"formSearch":        b.Search,
+		"formDatalist":      b.Datalist,
+		"formNumber":        b.Number,
+		"formHidden":        b.Hidden,
+		"formFile":          b.File,
+		"formFileMultiple":  b.FileMultiple,
+		"formColor":         b.Color,
+		"formDate":          b.Date,
+		"formTime":          b.Time,
+		"formDatetimeLocal": b.DatetimeLocal,
+		"formMonth":         b.Month,
+		"formWeek":          b.Week,
+		"formRange":         b.Range,
+		"formRangeAttrs":    b.RangeAttrs,
+		"formTextarea":      b.Textarea,
+		"formSelect":        b.Select,
+		"formSelectGroups":  b.SelectGroups,
+		"formMultiSelect":   b.MultiSelect,
+		"formTimezone":      b.TimezoneSelect,
+		"formTZField":       b.ClientTimezoneField,
+		"formTZHidden":      b.ClientTimezoneHidden,
+		"formCheckbox":      b.Checkbox,
+		"formSwitch":        b.Switch,
+		"formCheckboxGroup": b.CheckboxGroup,
+		"formRadio":         b.Radio,
+		"formRadioGroup":    b.RadioGroup,
+		"formSubmit":        b.Submit,
+		"formButton":        b.Button,
+		"formReset":         b.Reset,
+		"formImage":         b.Image,
+		"formGroup":         b.Group,
+		"formAuto":          b.Auto,
+		"formInputGroup":    b.InputGroup,
+		"formCurrency":      b.Currency,
+		"formFloating":      b.Floating,
+		"formMeter":         b.Meter,
+		"formProgress":      b.Progress,
+		"formFieldError":    b.FieldError,
+		"formValidFeedback": b.ValidFeedback,
+		"formHelpText":      b.HelpText,
+		"formErrors":        b.Errors,
+		"formErrorSummary":  b.ErrorSummary,
+		"formValue":         b.Value,
+		"formValueFor":      b.ValueFor,
+		"formTextFor":       b.TextFor,
+		"formTags":          b.Tags,
+		"formTextValue":     b.TextValue,
+		"formHiddenValue":   b.HiddenValue,
+		"formFieldset":      b.Fieldset,
+		"formStep":          b.Step,
+		"formCurrentStep":   b.CurrentStep,
+		"formOriginal":      b.OriginalField,
+		"formDateText":      b.DateText,
+		"formListBox":       b.ListBox,
+		"formVisibility":    b.VisibilityScript,
+		"formRecaptcha":     b.Recaptcha,
+		"formRecaptchaJS":   b.RecaptchaScript,
+		"formHoneypot":      b.Honeypot,
+		"formScript":        b.Script,
+		"formID":            b.ID,
+		"formValues":        b.Values,
+		"formHasError":      b.HasError,
+		"formIsInvalid":     b.IsInvalid,
+		"formError":         b.Error,
+		"formIndexed":       Indexed,
+		"formLen":           b.Len,
+	}
+}
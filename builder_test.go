@@ -1,9 +1,22 @@
 package builder
 
 import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
 	"github.com/stretchr/testify/assert"
+	"html/template"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 type TestForm struct {
@@ -19,6 +32,11 @@ func TestFormOpen(t *testing.T) {
 	assert.Contains(t, string(html), `name="_csrf" value="abc"`)
 }
 
+func TestFormClose(t *testing.T) {
+	form := New(Config{Action: "/test"})
+	assert.Equal(t, `</form>`, string(form.Close()))
+}
+
 func TestTextInputWithValueFromModel(t *testing.T) {
 	model := TestForm{Name: "John Doe"}
 	form := New(Config{Model: &model})
@@ -50,4 +68,1778 @@ func TestSelectWithOptions(t *testing.T) {
 	form := New(Config{OldInput: oldInput})
 	html := form.Select("role", options)
 	assert.Contains(t, string(html), `<option value="2" selected>User</option>`)
-}
\ No newline at end of file
+}
+func TestTextareaEscapesValueAndOmitsEmptyDimensions(t *testing.T) {
+	model := struct {
+		Bio string `form:"bio"`
+	}{Bio: "<b>Tom & Jerry</b>"}
+	form := New(Config{Model: &model, Errors: map[string]string{"bio": "Too short"}})
+	html := string(form.Textarea("bio", 0, 40))
+	assert.Contains(t, html, `&lt;b&gt;Tom &amp; Jerry&lt;/b&gt;</textarea>`)
+	assert.Contains(t, html, `class="form-control is-invalid"`)
+	assert.Contains(t, html, `cols="40"`)
+	assert.NotContains(t, html, `rows=`)
+	assert.Contains(t, string(form.Textarea("bio", 5, 0)), `rows="5"`)
+}
+
+func TestTextareaPrefersOldInput(t *testing.T) {
+	model := struct {
+		Bio string `form:"bio"`
+	}{Bio: "from model"}
+	form := New(Config{Model: &model, OldInput: url.Values{"bio": {"from input"}}})
+	assert.Contains(t, string(form.Textarea("bio", 0, 0)), `>from input</textarea>`)
+}
+
+func TestPasswordNeverEchoesValue(t *testing.T) {
+	model := struct {
+		Password string `form:"password"`
+	}{Password: "secret"}
+	form := New(Config{Model: &model, OldInput: url.Values{"password": {"typed"}}})
+	html := string(form.Password("password"))
+	assert.Contains(t, html, `type="password"`)
+	assert.NotContains(t, html, `value=`)
+}
+
+func TestTypedInputs(t *testing.T) {
+	form := New(Config{OldInput: url.Values{"site": {"https://example.com"}}})
+	assert.Contains(t, string(form.URL("site")), `type="url"`)
+	assert.Contains(t, string(form.URL("site")), `value="https://example.com"`)
+	assert.Contains(t, string(form.Email("email")), `type="email"`)
+	assert.Contains(t, string(form.Number("age")), `type="number"`)
+}
+
+func TestGroupCombinesLabelInputAndError(t *testing.T) {
+	form := New(Config{Errors: map[string]string{"name": "Name is required"}})
+	html := string(form.Group("name", "Your Name", form.Text("name")))
+	assert.Contains(t, html, `<div class="form-group mb-3"><label class="form-label" for="name">Your Name</label><input`)
+	assert.Contains(t, html, `id="name"`)
+	assert.Contains(t, html, `<div class="invalid-feedback" id="name-error">Name is required</div></div>`)
+}
+
+func TestLabelMarksRequiredFields(t *testing.T) {
+	model := struct {
+		Name  string `form:"name" validate:"required"`
+		Email string `form:"email" validate:"omitempty,email"`
+		Phone string `form:"phone" validate:"max=20,required"`
+	}{}
+	form := New(Config{Model: &model})
+	assert.Equal(t, `<label class="form-label" for="name">Name <span class="text-danger">*</span></label>`, string(form.Label("name", "Name")))
+	assert.NotContains(t, string(form.Label("email", "Email")), `text-danger`)
+	assert.Contains(t, string(form.Label("phone", "Phone")), `text-danger`)
+}
+
+func TestCheckboxBindsModelAndEmitsHiddenCompanion(t *testing.T) {
+	model := struct {
+		Active bool     `form:"active"`
+		Tags   []string `form:"tags"`
+	}{Active: true, Tags: []string{"go", "web"}}
+	form := New(Config{Model: &model, Errors: map[string]string{"active": "Required"}})
+	html := string(form.Checkbox("active", "1"))
+	assert.True(t, strings.HasPrefix(html, `<input type="hidden" name="active" value="">`))
+	assert.Contains(t, html, `checked="checked"`)
+	assert.Contains(t, html, `form-check-input is-invalid`)
+	assert.Contains(t, string(form.Checkbox("tags", "web")), `checked="checked"`)
+	assert.NotContains(t, string(form.Checkbox("tags", "rust")), `checked`)
+}
+
+func TestCheckboxPrefersOldInput(t *testing.T) {
+	model := struct {
+		Active bool `form:"active"`
+	}{Active: true}
+	form := New(Config{Model: &model, OldInput: url.Values{"active": {""}}})
+	assert.NotContains(t, string(form.Checkbox("active", "1")), `checked`)
+}
+
+func TestRadioGroupIsMutuallyExclusive(t *testing.T) {
+	model := struct {
+		Plan string `form:"plan"`
+	}{Plan: "pro"}
+	form := New(Config{Model: &model})
+	basic := string(form.Radio("plan", "basic"))
+	pro := string(form.Radio("plan", "pro"))
+	assert.Contains(t, basic, `id="plan_basic"`)
+	assert.Contains(t, basic, `value="basic"`)
+	assert.NotContains(t, basic, `checked`)
+	assert.Contains(t, pro, `id="plan_pro"`)
+	assert.Contains(t, pro, `checked="checked"`)
+
+	form = New(Config{Model: &model, OldInput: url.Values{"plan": {"basic"}}})
+	assert.Contains(t, string(form.Radio("plan", "basic")), `checked="checked"`)
+	assert.NotContains(t, string(form.Radio("plan", "pro")), `checked`)
+}
+
+func TestFormOpenSpoofsMethod(t *testing.T) {
+	html := string(New(Config{Action: "/users/1", Method: "put"}).Open())
+	assert.Contains(t, html, `method="POST"`)
+	assert.Contains(t, html, `<input type="hidden" name="_method" value="PUT">`)
+
+	html = string(New(Config{Action: "/users/1", Method: "DELETE", MethodField: "_verb"}).Open())
+	assert.Contains(t, html, `name="_verb" value="DELETE"`)
+
+	for _, method := range []string{"", "GET", "POST"} {
+		assert.NotContains(t, string(New(Config{Method: method}).Open()), `_method`)
+	}
+}
+
+func TestHiddenInputBindsValueWithoutClasses(t *testing.T) {
+	model := struct {
+		ID int `form:"id"`
+	}{ID: 42}
+	form := New(Config{Model: &model, Errors: map[string]string{"id": "Invalid"}})
+	assert.Equal(t, `<input type="hidden" name="id" id="id" value="42">`, string(form.Hidden("id")))
+
+	form = New(Config{Model: &model, OldInput: url.Values{"id": {"7"}}})
+	assert.Contains(t, string(form.Hidden("id")), `value="7"`)
+}
+
+func TestFileInputEnablesMultipart(t *testing.T) {
+	form := New(Config{Action: "/upload", Errors: map[string]string{"avatar": "Too large"}})
+	assert.NotContains(t, string(form.Open()), `enctype`)
+	html := string(form.FileMultiple("avatar"))
+	assert.Contains(t, html, `type="file"`)
+	assert.Contains(t, html, ` multiple>`)
+	assert.Contains(t, html, `is-invalid`)
+	assert.NotContains(t, string(form.Open()), `enctype`, "rendering a file input must not mutate the builder")
+
+	assert.Contains(t, string(New(Config{Multipart: true}).Open()), `enctype="multipart/form-data"`)
+	model := struct {
+		Avatar string `form:"avatar" type:"file"`
+	}{}
+	assert.Contains(t, string(New(Config{Model: model}).Open()), `enctype="multipart/form-data"`)
+	assert.Contains(t, string(form.WithModel(&model).Open()), `enctype="multipart/form-data"`)
+}
+
+func TestExtraAttributesAreSortedAndEscaped(t *testing.T) {
+	form := New(Config{})
+	html := string(form.Text("name", Attr{"placeholder": `Your "name"`, "autocomplete": "off", "data-foo": "bar", "readonly": ""}))
+	assert.Equal(t, `<input type="text" class="form-control" name="name" id="name" autocomplete="off" data-foo="bar" placeholder="Your &#34;name&#34;" readonly>`, html)
+}
+
+func TestSelectGroupsRendersOptgroups(t *testing.T) {
+	model := struct {
+		Country string `form:"country"`
+	}{Country: "fr"}
+	groups := []OptGroup{
+		{Options: []Option{{Value: "", Text: "None"}}},
+		{Label: "Europe", Options: []Option{{Value: "de", Text: "Germany"}, {Value: "fr", Text: "France"}}},
+	}
+	html := string(New(Config{Model: &model}).SelectGroups("country", groups))
+	assert.Contains(t, html, `><option value="">None</option><optgroup label="Europe">`)
+	assert.Contains(t, html, `<option value="fr" selected>France</option></optgroup>`)
+}
+
+func TestMultiSelectBindsSlices(t *testing.T) {
+	options := []Option{{Value: "1", Text: "One"}, {Value: "2", Text: "Two"}, {Value: "3", Text: "Three"}}
+	model := struct {
+		IDs []int `form:"ids"`
+	}{IDs: []int{1, 3}}
+	html := string(New(Config{Model: &model}).MultiSelect("ids", options))
+	assert.Contains(t, html, `name="ids[]" id="ids" multiple>`)
+	assert.Contains(t, html, `<option value="1" selected>One</option><option value="2">Two</option><option value="3" selected>Three</option>`)
+
+	html = string(New(Config{Model: &model, OldInput: url.Values{"ids[]": {"2"}}}).MultiSelect("ids", options))
+	assert.Contains(t, html, `<option value="1">One</option><option value="2" selected>Two</option>`)
+}
+
+func TestSubmitEscapesLabelAndAcceptsAttributes(t *testing.T) {
+	form := New(Config{})
+	assert.Equal(t, `<button type="submit" class="btn btn-primary">Save &amp; Close</button>`, string(form.Submit("Save & Close")))
+	html := string(form.Submit("Publish", Attr{"name": "action", "value": "publish", "class": "btn btn-success"}))
+	assert.Equal(t, `<button type="submit" class="btn btn-success" name="action" value="publish">Publish</button>`, html)
+}
+
+func TestErrorsRendersEveryMessage(t *testing.T) {
+	form := New(Config{FieldErrors: map[string][]string{"password": {"Too short", "Must contain a digit"}}})
+	assert.Contains(t, string(form.Password("password")), `is-invalid`)
+	assert.Equal(t, `<div class="invalid-feedback d-block" id="password-error">Too short</div><div class="invalid-feedback d-block">Must contain a digit</div>`, string(form.Errors("password")))
+
+	form = New(Config{Errors: map[string]string{"name": "Required"}})
+	assert.Equal(t, `<div class="invalid-feedback d-block" id="name-error">Required</div>`, string(form.Errors("name")))
+	assert.Empty(t, string(form.Errors("email")))
+}
+
+type testAddress struct {
+	City string `form:"city"`
+}
+
+func TestNestedFieldPaths(t *testing.T) {
+	model := struct {
+		Address  testAddress  `form:"address"`
+		Shipping *testAddress `form:"shipping"`
+	}{Address: testAddress{City: "Istanbul"}}
+	form := New(Config{Model: &model})
+	assert.Contains(t, string(form.Text("address.city")), `value="Istanbul"`)
+	assert.NotContains(t, string(form.Text("shipping.city")), `value=`)
+
+	model.Shipping = &testAddress{City: "Ankara"}
+	assert.Contains(t, string(form.Text("shipping.city")), `value="Ankara"`)
+
+	form = New(Config{Model: &model, OldInput: url.Values{"address.city": {"Izmir"}}})
+	assert.Contains(t, string(form.Text("address.city")), `value="Izmir"`)
+}
+
+func TestDateInputsFormatTimeValues(t *testing.T) {
+	model := struct {
+		Start   time.Time `form:"start"`
+		Deleted time.Time `form:"deleted"`
+	}{Start: time.Date(2024, 3, 9, 14, 5, 30, 0, time.UTC)}
+	form := New(Config{Model: &model})
+	assert.Contains(t, string(form.Date("start")), `value="2024-03-09"`)
+	assert.Contains(t, string(form.Time("start")), `value="14:05"`)
+	assert.Contains(t, string(form.DatetimeLocal("start")), `value="2024-03-09T14:05"`)
+	assert.Contains(t, string(form.Date("deleted")), `value=""`)
+
+	form = New(Config{Model: &model, OldInput: url.Values{"start": {"2025-01-01"}}})
+	assert.Contains(t, string(form.Date("start")), `value="2025-01-01"`)
+}
+
+func TestFuncMapInTemplates(t *testing.T) {
+	model := TestForm{Name: "John"}
+	form := New(Config{Action: "/save", Model: &model})
+	tmpl := template.Must(template.New("form").Funcs(form.FuncMap()).Parse(`{{ formOpen }}{{ formText "name" }}{{ formClose }}`))
+	var out strings.Builder
+	assert.NoError(t, tmpl.Execute(&out, nil))
+	assert.Contains(t, out.String(), `<form method="POST" action="/save">`)
+	assert.Contains(t, out.String(), `value="John"`)
+	assert.Contains(t, out.String(), `</form>`)
+}
+
+func TestFuncMapCoversFieldMethods(t *testing.T) {
+	aliases := map[string]string{
+		"TimezoneSelect":       "formTimezone",
+		"ClientTimezoneField":  "formTZField",
+		"ClientTimezoneHidden": "formTZHidden",
+		"OriginalField":        "formOriginal",
+		"VisibilityScript":     "formVisibility",
+		"RecaptchaScript":      "formRecaptchaJS",
+	}
+	form := New(Config{})
+	funcs := form.FuncMap()
+	value := reflect.ValueOf(form)
+	htmlType := reflect.TypeOf(template.HTML(""))
+	for i := 0; i < value.NumMethod(); i++ {
+		method := value.Type().Method(i)
+		if mt := method.Type; mt.NumOut() != 1 || mt.Out(0) != htmlType {
+			continue
+		}
+		key, ok := aliases[method.Name]
+		if !ok {
+			key = "form" + method.Name
+		}
+		fn, ok := funcs[key]
+		if assert.True(t, ok, "%s has no FuncMap entry %q", method.Name, key) {
+			assert.Equal(t, value.Method(i).Type(), reflect.TypeOf(fn), key)
+		}
+	}
+}
+
+func TestNewFormAppliesOptionsInOrder(t *testing.T) {
+	model := TestForm{Name: "John"}
+	form := NewForm("/profile", "PATCH",
+		CSRFOption("first"),
+		ModelOption(&model),
+		ErrorsOption(map[string]string{"name": "Taken"}),
+		MultipartOption(),
+		CSRFOption("second"),
+	)
+	open := string(form.Open())
+	assert.Contains(t, open, `name="_csrf" value="second"`)
+	assert.Contains(t, open, `enctype="multipart/form-data"`)
+	assert.Contains(t, open, `name="_method" value="PATCH"`)
+	assert.Contains(t, string(form.Text("name")), `value="John"`)
+	assert.Contains(t, string(form.Text("name")), `is-invalid`)
+}
+
+func TestWriterVariantsMatchHTMLMethods(t *testing.T) {
+	model := TestForm{Name: "John"}
+	form := New(Config{Action: "/save", Method: "PUT", CSRFToken: "abc", Model: &model})
+	var buf bytes.Buffer
+	assert.NoError(t, form.WriteOpen(&buf))
+	assert.NoError(t, form.WriteText(&buf, "name"))
+	assert.NoError(t, form.WriteSelect(&buf, "role", []Option{{Value: "1", Text: "Admin"}}))
+	assert.NoError(t, form.WriteTextarea(&buf, "email"))
+	assert.NoError(t, form.WriteClose(&buf))
+	expected := string(form.Open()) + string(form.Text("name")) + string(form.Select("role", []Option{{Value: "1", Text: "Admin"}})) + string(form.Textarea("email", 0, 0)) + string(form.Close())
+	assert.Equal(t, expected, buf.String())
+}
+
+func benchmarkFields() (*Builder, []string) {
+	oldInput := url.Values{}
+	names := make([]string, 50)
+	for i := range names {
+		names[i] = fmt.Sprintf("field_%d", i)
+		oldInput.Set(names[i], fmt.Sprintf("value %d", i))
+	}
+	return New(Config{Action: "/bench", OldInput: oldInput}), names
+}
+
+func BenchmarkRenderFormHTML(b *testing.B) {
+	form, names := benchmarkFields()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var html strings.Builder
+		html.WriteString(string(form.Open()))
+		for _, name := range names {
+			html.WriteString(string(form.Text(name, Attr{"placeholder": name})))
+		}
+		html.WriteString(string(form.Close()))
+	}
+}
+
+func BenchmarkRenderFormWriter(b *testing.B) {
+	form, names := benchmarkFields()
+	var buf bytes.Buffer
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		form.WriteOpen(&buf)
+		for _, name := range names {
+			form.WriteText(&buf, name, Attr{"placeholder": name})
+		}
+		form.WriteClose(&buf)
+	}
+}
+
+func TestColorInputNormalizesValue(t *testing.T) {
+	model := struct {
+		Primary   string `form:"primary"`
+		Secondary string `form:"secondary"`
+		Accent    string `form:"accent"`
+	}{Primary: "#FF8800", Secondary: "red", Accent: "#0af"}
+	form := New(Config{Model: &model, OldInput: url.Values{"accent": {"#123456"}}})
+	assert.Contains(t, string(form.Color("primary")), `value="#ff8800"`)
+	assert.Contains(t, string(form.Color("secondary")), `value="#000000"`)
+	assert.Contains(t, string(form.Color("missing")), `value="#000000"`)
+	assert.Contains(t, string(form.Color("accent")), `value="#123456"`)
+	assert.Contains(t, string(form.Color("accent")), `type="color"`)
+}
+
+func TestRangeClampsValue(t *testing.T) {
+	model := struct {
+		Volume int `form:"volume"`
+	}{Volume: 150}
+	form := New(Config{Model: &model})
+	html := string(form.Range("volume", 0, 100, 0))
+	assert.Contains(t, html, `class="form-range"`)
+	assert.Contains(t, html, `min="0"`)
+	assert.Contains(t, html, `max="100"`)
+	assert.Contains(t, html, `value="100"`)
+	assert.NotContains(t, html, `step=`)
+
+	form = New(Config{Model: &model, OldInput: url.Values{"volume": {"-5"}}})
+	html = string(form.Range("volume", 0, 100, 5))
+	assert.Contains(t, html, `step="5"`)
+	assert.Contains(t, html, `value="0"`)
+}
+
+func TestRangeAttrsClampsToValidationBounds(t *testing.T) {
+	model := struct {
+		Volume int `form:"volume" validate:"min=10,max=50"`
+	}{Volume: 80}
+	form := New(Config{Model: &model, HTML5Validation: true})
+	html := string(form.RangeAttrs("volume"))
+	assert.Contains(t, html, `min="10"`)
+	assert.Contains(t, html, `max="50"`)
+	assert.Contains(t, html, `value="50"`)
+
+	assert.Contains(t, string(form.RangeAttrs("volume", Attr{"max": "70"})), `value="70"`)
+}
+
+func TestHumanize(t *testing.T) {
+	assert.Equal(t, "First Name", humanize("first_name"))
+	assert.Equal(t, "First Name", humanize("firstName"))
+	assert.Equal(t, "Email", humanize("email"))
+}
+
+func TestAutoPlaceholderIsOptIn(t *testing.T) {
+	assert.NotContains(t, string(New(Config{}).Text("first_name")), `placeholder`)
+
+	form := New(Config{AutoPlaceholder: true})
+	assert.Contains(t, string(form.Text("first_name")), `placeholder="First Name"`)
+	assert.Contains(t, string(form.Textarea("shortBio", 0, 0)), `placeholder="Short Bio"`)
+	assert.Contains(t, string(form.Text("first_name", Attr{"placeholder": "Ada"})), `placeholder="Ada"`)
+	assert.NotContains(t, string(form.Hidden("user_id")), `placeholder`)
+}
+
+func TestDisabledAndReadonlyFields(t *testing.T) {
+	model := TestForm{Email: "a@b.co"}
+	form := New(Config{Model: &model, Errors: map[string]string{"email": "Invalid"}})
+
+	readonly := string(form.Email("email", Readonly()))
+	assert.Contains(t, readonly, ` readonly>`)
+	assert.Contains(t, readonly, `is-invalid`)
+	assert.Contains(t, readonly, `value="a@b.co"`)
+
+	disabled := string(form.Email("email", Disabled()))
+	assert.Contains(t, disabled, `<input type="email" class="form-control" name="email" id="email" value="a@b.co" disabled`)
+	assert.NotContains(t, disabled, `type="hidden"`)
+
+	kept := string(form.Email("email", DisabledWithValue()))
+	assert.Contains(t, kept, `<input type="hidden" name="email" value="a@b.co">`)
+	assert.NotContains(t, kept, directivePrefix)
+}
+
+func TestDisabledWithValueOnlyCopiesSubmittedValues(t *testing.T) {
+	model := struct {
+		Agree  bool     `form:"agree"`
+		Terms  bool     `form:"terms"`
+		Role   string   `form:"role"`
+		Secret string   `form:"secret"`
+		Bio    string   `form:"bio"`
+		Tags   []string `form:"tags"`
+	}{Terms: true, Role: "a", Secret: "s3cret", Bio: "Hi", Tags: []string{"x", "z"}}
+	form := New(Config{Model: &model})
+
+	assert.NotContains(t, string(form.Checkbox("agree", "1", DisabledWithValue())), `type="hidden" name="agree" value="1"`)
+	assert.Contains(t, string(form.Checkbox("terms", "1", DisabledWithValue())), `disabled><input type="hidden" name="terms" value="1">`)
+	assert.NotContains(t, string(form.Radio("role", "b", DisabledWithValue())), `type="hidden"`)
+	assert.Contains(t, string(form.Radio("role", "a", DisabledWithValue())), `<input type="hidden" name="role" value="a">`)
+	assert.NotContains(t, string(form.Password("secret", DisabledWithValue())), `type="hidden"`)
+
+	bio := string(form.Textarea("bio", 0, 0, DisabledWithValue()))
+	assert.Contains(t, bio, `</textarea><input type="hidden" name="bio" value="Hi">`)
+	assert.NotContains(t, bio, directivePrefix)
+
+	tags := string(form.MultiSelect("tags", []Option{{Value: "x", Text: "X"}, {Value: "y", Text: "Y"}, {Value: "z", Text: "Z"}}, DisabledWithValue()))
+	assert.Contains(t, tags, `</select><input type="hidden" name="tags[]" value="x"><input type="hidden" name="tags[]" value="z">`)
+	assert.NotContains(t, tags, directivePrefix)
+}
+
+func TestSwitchRendersBootstrapMarkup(t *testing.T) {
+	model := struct {
+		Notify bool `form:"notify"`
+	}{Notify: true}
+	html := string(New(Config{Model: &model}).Switch("notify", "1"))
+	assert.True(t, strings.HasPrefix(html, `<div class="form-check form-switch"><input type="hidden" name="notify" value="">`))
+	assert.Contains(t, html, `role="switch"`)
+	assert.Contains(t, html, `checked="checked"`)
+	assert.True(t, strings.HasSuffix(html, `</div>`))
+}
+
+func TestThemeControlsClassNames(t *testing.T) {
+	form := New(Config{Theme: &TailwindTheme, Errors: map[string]string{"name": "Required"}})
+	assert.Contains(t, string(form.Text("name")), `class="`+TailwindTheme.Input+` border-red-500"`)
+	assert.Contains(t, string(form.Label("name", "Name")), `class="`+TailwindTheme.Label+`"`)
+	assert.Contains(t, string(form.FieldError("name")), `<div class="mt-1 text-sm text-red-600" id="name-error">Required</div>`)
+	assert.NotContains(t, string(form.Select("role", []Option{})), `form-select`)
+
+	custom := BootstrapTheme
+	custom.ErrorInputClass = "has-error"
+	form = New(Config{Theme: &custom, Errors: map[string]string{"name": "Required"}})
+	assert.Contains(t, string(form.Text("name", Attr{"class": "form-control form-control-lg"})), `class="form-control form-control-lg has-error"`)
+}
+
+func TestValidStateRendering(t *testing.T) {
+	form := New(Config{Valid: map[string]bool{"email": true}})
+	assert.Contains(t, string(form.Email("email")), `class="form-control is-valid"`)
+	assert.Equal(t, `<div class="valid-feedback d-block">Looks good!</div>`, string(form.ValidFeedback("email", "Looks good!")))
+	assert.Empty(t, string(form.ValidFeedback("name", "Looks good!")))
+
+	form = New(Config{
+		InferValid: true,
+		OldInput:   url.Values{"name": {"John"}, "email": {"bad"}},
+		Errors:     map[string]string{"email": "Invalid"},
+	})
+	assert.Contains(t, string(form.Text("name")), `is-valid`)
+	assert.Contains(t, string(form.Email("email")), `is-invalid`)
+	assert.NotContains(t, string(form.Text("phone")), `is-valid`)
+}
+
+func TestButtonFamily(t *testing.T) {
+	form := New(Config{})
+	assert.Equal(t, `<button type="reset" class="btn btn-secondary">Clear &lt;all&gt;</button>`, string(form.Reset("Clear <all>")))
+	html := string(form.Button("Preview", "", Attr{"onclick": "preview()", "data-target": "#modal"}))
+	assert.Equal(t, `<button type="button" class="btn btn-secondary" data-target="#modal" onclick="preview()">Preview</button>`, html)
+	assert.Equal(t, `<button type="submit" class="btn btn-secondary">Save</button>`, string(form.Button("Save", "submit")))
+}
+
+func TestHTML5ValidationFromTags(t *testing.T) {
+	model := struct {
+		Name  string `form:"name" validate:"required,min=2,max=100"`
+		Age   int    `form:"age" validate:"gte=0,lte=130"`
+		Email string `form:"email" validate:"required,email"`
+		Code  string `form:"code" validate:"len=6"`
+	}{}
+	form := New(Config{Model: &model, HTML5Validation: true})
+	name := string(form.Text("name"))
+	assert.Contains(t, name, `maxlength="100"`)
+	assert.Contains(t, name, `minlength="2"`)
+	age := string(form.Number("age"))
+	assert.Contains(t, age, `max="130"`)
+	assert.Contains(t, age, `min="0"`)
+	assert.Contains(t, string(form.Text("email")), `type="email"`)
+	assert.Contains(t, string(form.Text("code")), `maxlength="6" minlength="6"`)
+	assert.Contains(t, string(form.Textarea("name", 0, 0, Attr{"maxlength": "50"})), `maxlength="50"`)
+
+	assert.NotContains(t, string(New(Config{Model: &model}).Text("name")), `maxlength`)
+}
+
+func TestPointerAndNullableModelFields(t *testing.T) {
+	nickname := "Johnny"
+	model := struct {
+		Nickname *string        `form:"nickname"`
+		Age      *int           `form:"age"`
+		Bio      sql.NullString `form:"bio"`
+		Score    sql.NullInt64  `form:"score"`
+	}{Nickname: &nickname, Score: sql.NullInt64{Int64: 42, Valid: true}}
+	form := New(Config{Model: &model})
+	assert.Contains(t, string(form.Text("nickname")), `value="Johnny"`)
+	assert.NotContains(t, string(form.Number("age")), `value=`)
+	assert.NotContains(t, string(form.Text("bio")), `value=`)
+	assert.Contains(t, string(form.Number("score")), `value="42"`)
+}
+
+func TestInputGroupWrapsAddonsAndError(t *testing.T) {
+	form := New(Config{Errors: map[string]string{"price": "Required"}})
+	html := string(form.InputGroup("price", "$", ".00", form.Number("price")))
+	assert.True(t, strings.HasPrefix(html, `<div class="input-group"><span class="input-group-text">$</span><input`))
+	assert.Contains(t, html, `<span class="input-group-text">.00</span></div><div class="invalid-feedback d-block" id="price-error">Required</div>`)
+
+	html = string(New(Config{}).InputGroup("user", "@", "", form.Text("user")))
+	assert.Equal(t, 1, strings.Count(html, "input-group-text"))
+	assert.True(t, strings.HasSuffix(html, `</div>`))
+}
+
+func TestCheckboxGroupRendersOptions(t *testing.T) {
+	options := []Option{{Value: "go", Text: "Go"}, {Value: "rust", Text: "Rust & Co"}}
+	model := struct {
+		Langs []string `form:"langs"`
+	}{Langs: []string{"rust"}}
+	form := New(Config{Model: &model, Errors: map[string]string{"langs": "Pick one"}})
+	html := string(form.CheckboxGroup("langs", options))
+	assert.Equal(t, 1, strings.Count(html, `type="hidden"`))
+	assert.True(t, strings.HasPrefix(html, `<input type="hidden" name="langs[]" value="">`))
+	assert.Contains(t, html, `<div class="form-check"><input type="checkbox" class="form-check-input is-invalid" name="langs[]" id="langs_go" value="go" aria-describedby="langs-error" aria-invalid="true"><label class="form-check-label" for="langs_go">Go</label></div>`)
+	assert.Contains(t, html, `id="langs_rust" value="rust" aria-describedby="langs-error" aria-invalid="true" checked="checked"`)
+	assert.Contains(t, html, `>Rust &amp; Co</label>`)
+
+	form = New(Config{Model: &model, OldInput: url.Values{"langs[]": {"", "go"}}})
+	html = string(form.CheckboxGroup("langs", options))
+	assert.Contains(t, html, `id="langs_go" value="go" checked="checked"`)
+	assert.NotContains(t, html, `id="langs_rust" value="rust" checked="checked"`)
+}
+
+func TestIDReplacesWhitespace(t *testing.T) {
+	form := New(Config{})
+	html := string(form.Checkbox("agree", "yes please"))
+	assert.Contains(t, html, `id="agree_yes_please" value="yes please"`)
+	assert.Equal(t, "items_0_first_name", form.ID("items[0][first\tname]"))
+}
+
+func TestRadioGroup(t *testing.T) {
+	options := []Option{{Value: "s", Text: "Small"}, {Value: "m", Text: "Medium"}}
+	model := struct {
+		Size string `form:"size"`
+	}{Size: "m"}
+	form := New(Config{Model: &model})
+	html := string(form.RadioGroup("size", options))
+	assert.Contains(t, html, `<div class="form-check"><input type="radio" class="form-check-input" name="size" id="size_s" value="s"><label class="form-check-label" for="size_s">Small</label></div>`)
+	assert.Contains(t, html, `id="size_m" value="m" checked="checked"`)
+
+	inline := string(form.RadioGroup("size", options, Inline()))
+	assert.Equal(t, 2, strings.Count(inline, `<div class="form-check form-check-inline">`))
+	assert.NotContains(t, inline, directivePrefix)
+
+	prefixed := string(form.RadioGroup("size", options, Attr{"id": "pick"}))
+	assert.Contains(t, prefixed, `id="pick_s" value="s"><label class="form-check-label" for="pick_s">`)
+	assert.Contains(t, prefixed, `id="pick_m" value="m" checked="checked"><label class="form-check-label" for="pick_m">`)
+	assert.NotContains(t, prefixed, `id="pick"`)
+}
+
+func TestNonceIsAppliedToScripts(t *testing.T) {
+	form := New(Config{Nonce: "r4nd0m+/="})
+	assert.Equal(t, "r4nd0m+/=", form.Nonce())
+	assert.Equal(t, `<script nonce="r4nd0m+/=">init()</script>`, string(form.Script("init()")))
+
+	form = New(Config{Nonce: `x" onload="alert(1)`})
+	assert.Empty(t, form.Nonce())
+	assert.Equal(t, `<script>init()</script>`, string(form.Script("init()")))
+}
+
+func TestHoneypot(t *testing.T) {
+	html := string(New(Config{OldInput: url.Values{"website": {"spam"}}}).Honeypot("website"))
+	assert.Contains(t, html, `aria-hidden="true"`)
+	assert.Contains(t, html, `<input type="text" name="website" id="website" value="" autocomplete="off" tabindex="-1">`)
+	assert.True(t, CheckHoneypot(url.Values{"website": {""}}, "website"))
+	assert.True(t, CheckHoneypot(url.Values{}, "website"))
+	assert.False(t, CheckHoneypot(url.Values{"website": {"http://spam.example"}}, "website"))
+}
+
+func TestCloneAndWithMethodsDoNotMutateOriginal(t *testing.T) {
+	base := New(Config{Action: "/contact", CSRFToken: "base"})
+	model := TestForm{Name: "John"}
+	req := base.WithCSRF("req").WithModel(&model).WithErrors(map[string]string{"name": "Taken"}).WithOldInput(url.Values{"email": {"j@x.io"}})
+
+	assert.Contains(t, string(req.Open()), `value="req"`)
+	assert.Contains(t, string(req.Text("name")), `value="John"`)
+	assert.Contains(t, string(req.Text("name")), `is-invalid`)
+	assert.Contains(t, string(req.Email("email")), `value="j@x.io"`)
+
+	assert.Contains(t, string(base.Open()), `value="base"`)
+	assert.NotContains(t, string(base.Text("name")), `value=`)
+	assert.NotContains(t, string(base.Text("name")), `is-invalid`)
+	assert.NotSame(t, base, base.Clone())
+}
+
+func TestSelectPlaceholderOption(t *testing.T) {
+	options := []Option{{Value: "1", Text: "Admin"}}
+	html := string(New(Config{}).Select("role", options, Placeholder("Select a role")))
+	assert.Contains(t, html, `<option value="" disabled selected>Select a role</option><option value="1">Admin</option>`)
+	assert.NotContains(t, html, `placeholder=`)
+
+	html = string(New(Config{OldInput: url.Values{"role": {"1"}}}).Select("role", options, Placeholder("Select a role")))
+	assert.Contains(t, html, `<option value="" disabled>Select a role</option><option value="1" selected>Admin</option>`)
+
+	assert.Contains(t, string(New(Config{}).Text("name", Placeholder("Jane"))), `placeholder="Jane"`)
+}
+
+func TestOptionGenerators(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []user{{1, "Ada"}, {2, "Linus"}}
+	options := Options(users, func(u user) string { return fmt.Sprint(u.ID) }, func(u user) string { return u.Name })
+	assert.Equal(t, []Option{{Value: "1", Text: "Ada"}, {Value: "2", Text: "Linus"}}, options)
+
+	assert.Equal(t, []Option{{Value: "de", Text: "Germany"}, {Value: "tr", Text: "Turkey"}}, OptionsFromMap(map[string]string{"tr": "Turkey", "de": "Germany"}))
+}
+
+func TestAriaDescribedByWiring(t *testing.T) {
+	form := New(Config{
+		Help:   map[string]string{"email": "We never share it."},
+		Errors: map[string]string{"email": "Invalid"},
+	})
+	html := string(form.Email("email"))
+	assert.Contains(t, html, `aria-describedby="email-help email-error"`)
+	assert.Contains(t, html, `aria-invalid="true"`)
+	assert.Equal(t, `<div class="form-text" id="email-help">We never share it.</div>`, string(form.HelpText("email", "")))
+	assert.Contains(t, string(form.FieldError("email")), `id="email-error"`)
+
+	assert.NotContains(t, string(form.Text("name")), `aria-`)
+	assert.Equal(t, `<div class="form-text" id="name-help">Full name</div>`, string(form.HelpText("name", "Full name")))
+}
+
+func TestGetFormPreservesActionQuery(t *testing.T) {
+	form := New(Config{Action: "/search?sort=name&tag=a&tag=b&page=3", Method: "GET", ExcludeQuery: []string{"page"}})
+	html := string(form.Open())
+	assert.Contains(t, html, "<input type=\"hidden\" name=\"sort\" value=\"name\">\n<input type=\"hidden\" name=\"tag\" value=\"a\">\n<input type=\"hidden\" name=\"tag\" value=\"b\">")
+	assert.NotContains(t, html, `name="page"`)
+
+	assert.NotContains(t, string(New(Config{Action: "/save?x=1", Method: "POST"}).Open()), `name="x"`)
+}
+
+func TestSignedCSRFTokens(t *testing.T) {
+	secret := []byte("s3cr3t")
+	token := NewCSRFToken(secret, "session-1", time.Hour)
+	assert.True(t, ValidateCSRFToken(secret, "session-1", token))
+	assert.False(t, ValidateCSRFToken(secret, "session-2", token))
+	assert.False(t, ValidateCSRFToken([]byte("other"), "session-1", token))
+	assert.False(t, ValidateCSRFToken(secret, "session-1", token+"x"))
+	assert.False(t, ValidateCSRFToken(secret, "session-1", "garbage"))
+	assert.False(t, ValidateCSRFToken(secret, "session-1", NewCSRFToken(secret, "session-1", -time.Minute)))
+
+	assert.Contains(t, string(New(Config{CSRFToken: token}).Open()), token)
+}
+
+func TestMonthAndWeekInputs(t *testing.T) {
+	model := struct {
+		Period time.Time `form:"period"`
+		Empty  time.Time `form:"empty"`
+	}{Period: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)}
+	form := New(Config{Model: &model})
+	assert.Contains(t, string(form.Month("period")), `<input type="month" class="form-control" name="period" id="period" value="2021-01">`)
+	assert.Contains(t, string(form.Week("period")), `<input type="week" class="form-control" name="period" id="period" value="2020-W53">`)
+	assert.Contains(t, string(form.Week("empty")), `value=""`)
+	assert.Contains(t, string(New(Config{Model: &model, OldInput: url.Values{"period": {"2022-W05"}}}).Week("period")), `value="2022-W05"`)
+}
+
+func TestTelAndSearchInputs(t *testing.T) {
+	form := New(Config{OldInput: url.Values{"phone": {"+90 555 000"}}, Errors: map[string]string{"q": "Too short"}})
+	tel := string(form.Tel("phone", Phone()))
+	assert.Contains(t, tel, `<input type="tel" class="form-control" name="phone" id="phone" value="+90 555 000"`)
+	assert.Contains(t, tel, `pattern="`+template.HTMLEscapeString(PhonePattern)+`"`)
+	assert.Contains(t, string(form.Search("q")), `class="form-control is-invalid"`)
+	assert.Contains(t, string(form.Search("q")), `type="search"`)
+}
+
+func TestAutoScaffoldsFieldsFromStruct(t *testing.T) {
+	model := struct {
+		ID       int       `form:"id" type:"hidden"`
+		Name     string    `form:"full_name" validate:"required"`
+		Email    string    `form:"email" label:"Email address" type:"email" placeholder:"you@example.com"`
+		Bio      string    `form:"bio" type:"textarea"`
+		Age      int       `form:"age"`
+		Birthday time.Time `form:"birthday"`
+		Active   bool      `form:"active"`
+		Secret   string    `form:"-"`
+		tags     []string
+	}{ID: 7, Name: "Ada", Active: true}
+	html := string(New(Config{Model: &model}).Auto())
+	assert.Contains(t, html, `<input type="hidden" name="id" id="id" value="7">`)
+	assert.Contains(t, html, `<label class="form-label" for="full_name">Full Name <span class="text-danger">*</span></label><input type="text" class="form-control" name="full_name" id="full_name" value="Ada">`)
+	assert.Contains(t, html, `>Email address</label><input type="email" class="form-control" name="email" id="email" value="" placeholder="you@example.com"`)
+	assert.Contains(t, html, `<textarea class="form-control" name="bio" id="bio"></textarea>`)
+	assert.Contains(t, html, `type="number" class="form-control" name="age" id="age" value="0"`)
+	assert.Contains(t, html, `type="date" class="form-control" name="birthday" id="birthday" value=""`)
+	assert.Contains(t, html, `id="active_1" value="1" checked="checked"`)
+	assert.NotContains(t, html, `Secret`)
+	assert.NotContains(t, html, `tags`)
+}
+
+func TestTranslatorHook(t *testing.T) {
+	dict := map[string]string{"form.email": "E-posta", "form.save": "Kaydet", "errors.required": "Zorunlu alan"}
+	translate := func(key string) string { return dict[key] }
+	form := New(Config{Translator: translate, Errors: map[string]string{"email": "errors.required"}})
+	assert.Equal(t, `<label class="form-label" for="email">E-posta</label>`, string(form.Label("email", "form.email")))
+	assert.Equal(t, `<label class="form-label" for="name">Name</label>`, string(form.Label("name", "Name")))
+	assert.Contains(t, string(form.Submit("form.save")), `>Kaydet</button>`)
+	assert.Contains(t, string(form.FieldError("email")), `>errors.required</div>`)
+
+	form = New(Config{Translator: translate, TranslateErrors: true, Errors: map[string]string{"email": "errors.required"}})
+	assert.Contains(t, string(form.FieldError("email")), `>Zorunlu alan</div>`)
+}
+
+func TestFormOpenEscapesAction(t *testing.T) {
+	html := string(New(Config{Action: `/x" onmouseover="alert(1)`, CSRFToken: `"><script>`}).Open())
+	assert.Contains(t, html, `action="/x&#34; onmouseover=&#34;alert(1)"`)
+	assert.NotContains(t, html, `" onmouseover="`)
+	assert.NotContains(t, html, `<script>`)
+
+	assert.Contains(t, string(New(Config{Action: "http://[::1"}).Open()), `action=""`)
+}
+
+func TestCheckboxOldInputIsAuthoritative(t *testing.T) {
+	model := struct {
+		Agree bool `form:"agree"`
+	}{Agree: true}
+
+	// Gizli eşlik alanından gelen boş değer, modeldeki true değerini ezer.
+	b := New(Config{Model: model, OldInput: url.Values{"agree": {""}}})
+	assert.NotContains(t, string(b.Checkbox("agree", "1")), "checked")
+
+	// Eski girdide yalnızca ilgisiz anahtarlar varsa modeldeki değer kullanılır.
+	b = New(Config{Model: model, OldInput: url.Values{"name": {"Ada"}, "email": {"ada@example.com"}}})
+	assert.Contains(t, string(b.Checkbox("agree", "1")), "checked")
+	assert.Contains(t, string(b.Switch("agree", "1")), "checked")
+
+	b = New(Config{Model: model, OldInput: url.Values{"agree": {"", "1"}}})
+	assert.Contains(t, string(b.Checkbox("agree", "1")), "checked")
+
+	b = New(Config{Model: model})
+	assert.Contains(t, string(b.Checkbox("agree", "1")), "checked")
+}
+
+func TestDatalist(t *testing.T) {
+	b := New(Config{OldInput: url.Values{"city": {"Ankara"}}})
+	html := string(b.Datalist("city", []string{"Ankara", "İzmir", `"x"`}))
+	assert.Contains(t, html, `list="city-list"`)
+	assert.Contains(t, html, `value="Ankara"`)
+	assert.Contains(t, html, `<datalist id="city-list"><option value="Ankara"><option value="İzmir"><option value="&#34;x&#34;"></datalist>`)
+}
+
+func TestErrorKeyStyle(t *testing.T) {
+	model := struct {
+		Email   string `form:"email"`
+		Address struct {
+			City string `form:"city"`
+		} `form:"address"`
+	}{}
+	errors := map[string]string{"Email": "bad email", "Address.City": "bad city"}
+
+	b := New(Config{Model: model, Errors: errors})
+	assert.NotContains(t, string(b.FieldError("email")), "bad email")
+
+	b = New(Config{Model: model, Errors: errors, ErrorKeyStyle: ErrorKeyField})
+	assert.Contains(t, string(b.FieldError("email")), "bad email")
+	assert.Contains(t, string(b.FieldError("address.city")), "bad city")
+
+	b = New(Config{Model: model, Errors: map[string]string{"email": "by tag", "Email": "by field"}, ErrorKeyStyle: ErrorKeyBoth})
+	assert.Contains(t, string(b.FieldError("email")), "by tag")
+	b = b.WithErrors(map[string]string{"Email": "by field"})
+	assert.Contains(t, string(b.Text("email")), "is-invalid")
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) { return 0, fmt.Errorf("write failed") }
+
+func TestRenderWritesWholeForm(t *testing.T) {
+	model := struct {
+		Name   string `form:"name"`
+		Avatar string `form:"avatar" type:"file"`
+	}{Name: "Ada"}
+	form := New(Config{Action: "/users", Method: "POST", Model: model})
+
+	var buf bytes.Buffer
+	assert.NoError(t, form.Render(&buf))
+	html := buf.String()
+	assert.True(t, strings.HasPrefix(html, `<form method="POST" action="/users" enctype="multipart/form-data">`))
+	assert.Contains(t, html, `value="Ada"`)
+	assert.Contains(t, html, `<button type="submit" class="btn btn-primary">Submit</button></form>`)
+	assert.Contains(t, string(form.Open()), "multipart")
+
+	assert.EqualError(t, form.Render(failingWriter{}), "write failed")
+
+	buf.Reset()
+	tr := map[string]string{"Kaydet": "Save"}
+	form = New(Config{Model: model, SubmitLabel: "Kaydet", Translator: func(key string) string { return tr[key] }})
+	assert.NoError(t, form.Render(&buf))
+	assert.Contains(t, buf.String(), `>Save</button></form>`)
+}
+
+func TestFluentField(t *testing.T) {
+	form := New(Config{Model: TestForm{Email: "a@b.co"}, Errors: map[string]string{"email": "Invalid"}})
+	html := string(form.Field("email").Type("email").Placeholder("you@x.com").Required().Help("We never share it").Render())
+	assert.Contains(t, html, `aria-describedby="email-help email-error"`)
+	assert.Contains(t, html, `<input type="email" class="form-control is-invalid" name="email" id="email" value="a@b.co" aria-describedby="email-help email-error" aria-invalid="true" placeholder="you@x.com" required>`)
+	assert.Contains(t, html, `<div class="form-text" id="email-help">We never share it</div>`)
+	assert.Contains(t, html, `id="email-error">Invalid</div>`)
+
+	grouped := string(form.Field("bio").Type("textarea").Label("Bio").Render())
+	assert.True(t, strings.HasPrefix(grouped, `<div class="form-group mb-3"><label`))
+	assert.Contains(t, grouped, `<textarea`)
+}
+
+func TestIndexedFieldNames(t *testing.T) {
+	type line struct {
+		Name string `form:"name"`
+		Qty  int    `form:"qty"`
+	}
+	model := struct {
+		Items []line `form:"items"`
+	}{Items: []line{{"Pen", 2}, {"Ink", 1}}}
+
+	form := New(Config{Model: &model, Errors: map[string]string{"Items[1].Qty": "too few"}, ErrorKeyStyle: ErrorKeyBoth})
+	assert.Equal(t, "items[1][qty]", Indexed("items", 1, "qty"))
+	assert.Equal(t, 2, form.Len("items"))
+	assert.Contains(t, string(form.Text(Indexed("items", 0, "name"))), `type="text" class="form-control" name="items[0][name]" id="items_0_name" value="Pen"`)
+	assert.Contains(t, string(form.Number(Indexed("items", 1, "qty"))), `value="1"`)
+	assert.Contains(t, string(form.Number(Indexed("items", 1, "qty"))), `is-invalid`)
+	assert.NotContains(t, string(form.Text(Indexed("items", 5, "name"))), `value=`)
+
+	form = form.WithOldInput(url.Values{"items[0][name]": {"Pencil"}, "items[2][name]": {"Eraser"}})
+	assert.Equal(t, 3, form.Len("items"))
+	assert.Contains(t, string(form.Text(Indexed("items", 0, "name"))), `value="Pencil"`)
+}
+
+func TestFloatingLabel(t *testing.T) {
+	form := New(Config{Model: TestForm{Email: "a@b.co"}, Errors: map[string]string{"email": "Invalid"}})
+	html := string(form.Floating("email", "Email address"))
+	assert.Equal(t, `<div class="form-floating mb-3"><input type="text" class="form-control is-invalid" name="email" id="email" value="a@b.co" aria-describedby="email-error" aria-invalid="true" placeholder="Email address"><label for="email">Email address</label><div class="invalid-feedback d-block" id="email-error">Invalid</div></div>`, html)
+	assert.Contains(t, string(New(Config{}).Floating("pw", "Password", Attr{"type": "password"})), `type="password"`)
+}
+
+func TestValueExposesResolvedValue(t *testing.T) {
+	model := struct {
+		Name    string    `form:"name"`
+		Tags    []string  `form:"tags"`
+		Born    time.Time `form:"born"`
+		Address struct {
+			City string `form:"city"`
+		} `form:"address"`
+	}{Name: "Ada", Tags: []string{"a", "b"}, Born: time.Date(1815, 12, 10, 0, 0, 0, 0, time.UTC)}
+	model.Address.City = "London"
+
+	form := New(Config{Model: model})
+	assert.Equal(t, "Ada", form.Value("name"))
+	assert.Equal(t, "London", form.Value("address.city"))
+	assert.Equal(t, "1815-12-10T00:00:00Z", form.Value("born"))
+	assert.Equal(t, []string{"a", "b"}, form.Values("tags"))
+	assert.Equal(t, "", form.Value("missing"))
+
+	form = form.WithOldInput(url.Values{"name": {"Grace"}})
+	assert.Equal(t, "Grace", form.Value("name"))
+}
+
+func TestErrorPredicates(t *testing.T) {
+	form := New(Config{Errors: map[string]string{"email": "Invalid"}, FieldErrors: map[string][]string{"tags": {"first", "second"}}})
+	assert.True(t, form.HasError("email"))
+	assert.True(t, form.IsInvalid("tags[]"))
+	assert.False(t, form.HasError("name"))
+	assert.Equal(t, "Invalid", form.Error("email"))
+	assert.Equal(t, "first", form.Error("tags"))
+	assert.Equal(t, "", form.Error("name"))
+
+	tmpl := template.Must(template.New("t").Funcs(form.FuncMap()).Parse(`{{if formHasError "email"}}<span title="{{formError "email"}}">!</span>{{end}}`))
+	var buf bytes.Buffer
+	assert.NoError(t, tmpl.Execute(&buf, nil))
+	assert.Equal(t, `<span title="Invalid">!</span>`, buf.String())
+}
+
+func TestCustomWidgetTemplates(t *testing.T) {
+	templates := map[string]*template.Template{
+		"text":   template.Must(template.New("text").Parse(`<x-input {{.Attributes}}>{{if .HasError}}<x-error>{{.Error}}</x-error>{{end}}</x-input>`)),
+		"label":  template.Must(template.New("label").Parse(`<x-label for="{{.ID}}">{{.Text}}{{if .Required}}*{{end}}</x-label>`)),
+		"select": template.Must(template.New("select").Parse(`<x-select name="{{.Name}}" value="{{.Value}}"></x-select>`)),
+	}
+	form := New(Config{
+		Model:     TestForm{Name: "Ada"},
+		Errors:    map[string]string{"name": "Too <short>"},
+		Templates: templates,
+	})
+
+	assert.Equal(t, `<x-input type="text" class="form-control is-invalid" name="name" id="name" value="Ada" aria-describedby="name-error" aria-invalid="true"><x-error>Too &lt;short&gt;</x-error></x-input>`, string(form.Text("name")))
+	assert.Equal(t, `<x-label for="name">Name &amp; surname*</x-label>`, string(form.Label("name", "Name & surname")))
+	assert.Equal(t, `<x-select name="role" value="admin"></x-select>`, string(form.WithOldInput(url.Values{"role": {"admin"}}).Select("role", []Option{{Value: "admin", Text: "Admin"}})))
+	assert.True(t, strings.HasPrefix(string(form.Email("email")), `<input `))
+}
+
+func TestNumberFormatsFloats(t *testing.T) {
+	model := struct {
+		Price float64 `form:"price"`
+		Ratio float64 `form:"ratio"`
+		Big   float64 `form:"big"`
+		Small float32 `form:"small"`
+		Count int     `form:"count"`
+	}{Price: 1.5, Ratio: 0.1 + 0.2, Big: 1e21, Small: 0.1, Count: 3}
+	form := New(Config{Model: model})
+
+	assert.Contains(t, string(form.Number("ratio")), `value="0.3"`)
+	assert.Contains(t, string(form.Number("big")), `value="1000000000000000000000"`)
+	assert.Equal(t, "1234567.1", formatFloat(1234567.1, -1))
+	assert.Equal(t, "98765432.12", formatFloat(98765432.12, -1))
+	assert.Equal(t, "0.000000123", formatFloat(0.000000123, -1))
+	assert.Contains(t, string(form.Number("small")), `value="0.1"`)
+	assert.Contains(t, string(form.Number("count")), `value="3"`)
+	assert.Equal(t, "1.5", form.Value("price"))
+
+	price := string(form.Number("price", Precision(2), Attr{"step": "0.01"}))
+	assert.Contains(t, price, `value="1.50" step="0.01">`)
+	assert.Contains(t, string(form.Number("small", Precision(3))), `value="0.100"`)
+	assert.NotContains(t, price, directivePrefix)
+
+	form = form.WithOldInput(url.Values{"price": {"1.500"}})
+	assert.Contains(t, string(form.Number("price", Precision(2))), `value="1.500"`)
+}
+
+type testStatus int
+
+func (s testStatus) String() string { return [...]string{"draft", "published"}[s] }
+
+func TestSelectMatchesNonStringModelFields(t *testing.T) {
+	model := struct {
+		Level  int        `form:"level"`
+		Size   int64      `form:"size"`
+		Active bool       `form:"active"`
+		Status testStatus `form:"status"`
+		Owner  *int       `form:"owner"`
+	}{Level: 2, Size: 9000000000, Active: true, Status: 1}
+	form := New(Config{Model: model})
+
+	assert.Contains(t, string(form.Select("level", []Option{{Value: "1", Text: "One"}, {Value: "2", Text: "Two"}})), `<option value="2" selected>Two</option>`)
+	assert.Contains(t, string(form.Select("size", []Option{{Value: "9000000000", Text: "Huge"}})), `<option value="9000000000" selected>`)
+	assert.Contains(t, string(form.Select("active", []Option{{Value: "true", Text: "Yes"}, {Value: "false", Text: "No"}})), `<option value="true" selected>Yes</option><option value="false">No</option>`)
+	assert.Contains(t, string(form.Select("status", []Option{{Value: "draft", Text: "Draft"}, {Value: "published", Text: "Published"}})), `<option value="published" selected>`)
+	assert.NotContains(t, string(form.Select("owner", []Option{{Value: "0", Text: "Nobody"}})), `selected`)
+}
+
+func TestErrorSummary(t *testing.T) {
+	assert.Equal(t, template.HTML(""), New(Config{}).ErrorSummary())
+
+	form := New(Config{
+		GlobalErrors: []string{"Please fix the errors below."},
+		Errors:       map[string]string{"name": "Name is required", "email": "Email <invalid>"},
+		FieldErrors:  map[string][]string{"tags": {"Too many", "Duplicate"}},
+	})
+	assert.Equal(t, `<div class="alert alert-danger" role="alert"><ul><li>Please fix the errors below.</li><li>Email &lt;invalid&gt;</li><li>Name is required</li><li>Too many</li><li>Duplicate</li></ul></div>`, string(form.ErrorSummary()))
+}
+
+func TestAutocompleteAndInputMode(t *testing.T) {
+	html := string(New(Config{}).Text("code", Autocomplete("one-time-code"), InputMode("numeric")))
+	assert.Contains(t, html, `autocomplete="one-time-code"`)
+	assert.Contains(t, html, `inputmode="numeric"`)
+
+	assert.Equal(t, Attr{"autocomplete": "section-blue shipping postal-code"}, Autocomplete("section-blue Shipping postal-code"))
+	assert.Equal(t, Attr{"autocomplete": "username webauthn"}, Autocomplete("username webauthn"))
+	assert.Empty(t, Autocomplete("favourite-color"))
+	assert.Empty(t, Autocomplete("billing off"))
+	assert.Empty(t, InputMode("phone"))
+}
+
+func TestAddHiddenFields(t *testing.T) {
+	base := New(Config{Action: "/orders/1", Method: "PATCH", CSRFToken: "abc"})
+	form := base.Clone()
+	form.AddHidden("tz", "Europe/Istanbul")
+	form.AddHidden("return_to", "/orders?page=2")
+
+	assert.Equal(t, "<form method=\"POST\" action=\"/orders/1\">\n"+
+		"<input type=\"hidden\" name=\"_csrf\" value=\"abc\">\n"+
+		"<input type=\"hidden\" name=\"_method\" value=\"PATCH\">\n"+
+		"<input type=\"hidden\" name=\"tz\" value=\"Europe/Istanbul\">\n"+
+		"<input type=\"hidden\" name=\"return_to\" value=\"/orders?page=2\">\n", string(form.Open()))
+	assert.NotContains(t, string(base.Open()), "tz")
+}
+
+func TestCheckboxBoolBinding(t *testing.T) {
+	model := struct {
+		Active bool `form:"active"`
+		Hidden bool `form:"hidden"`
+	}{Active: true}
+	form := New(Config{Model: model})
+
+	active := string(form.Checkbox("active", ""))
+	assert.Contains(t, active, `value="1"`)
+	assert.Contains(t, active, `checked`)
+	assert.NotContains(t, string(form.Checkbox("hidden", "1")), `checked`)
+	assert.Contains(t, string(form.Checkbox("hidden", "false")), `checked`)
+	assert.NotContains(t, string(form.Checkbox("active", "0")), `checked`)
+
+	submitted := form.WithOldInput(url.Values{"active": {"", "on"}, "hidden": {""}})
+	assert.Contains(t, string(submitted.Checkbox("active", "1")), `checked`)
+	assert.NotContains(t, string(submitted.Checkbox("hidden", "1")), `checked`)
+	assert.Contains(t, string(form.WithOldInput(url.Values{"active": {"true"}}).Checkbox("active", "on")), `checked`)
+}
+
+func TestConcurrentRendering(t *testing.T) {
+	model := struct {
+		Name   string   `form:"name" validate:"required,max=20"`
+		Tags   []string `form:"tags"`
+		Avatar string   `form:"avatar" type:"file"`
+	}{Name: "Ada", Tags: []string{"go"}}
+	form := New(Config{
+		Action:          "/users?tab=1",
+		Method:          "GET",
+		CSRFToken:       "abc",
+		Model:           &model,
+		OldInput:        url.Values{"name": {"Grace"}},
+		Errors:          map[string]string{"name": "Too long"},
+		HTML5Validation: true,
+	})
+	shared := Attr{"class": "custom"}
+	want := string(form.Open()) + string(form.Auto()) + string(form.File("avatar", shared))
+
+	var wg sync.WaitGroup
+	results := make([]string, 32)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			form.Select("tags", []Option{{Value: "go", Text: "Go"}}, shared)
+			form.CheckboxGroup("tags", []Option{{Value: "go", Text: "Go"}}, shared)
+			form.Label("name", "Name")
+			results[i] = string(form.Open()) + string(form.Auto()) + string(form.File("avatar", shared))
+		}(i)
+	}
+	wg.Wait()
+	for _, got := range results {
+		assert.Equal(t, want, got)
+	}
+	assert.Equal(t, Attr{"class": "custom"}, shared)
+}
+
+func TestSelectDisabledOptions(t *testing.T) {
+	form := New(Config{OldInput: url.Values{"size": {"xl"}}})
+	html := string(form.Select("size", []Option{{Value: "m", Text: "M"}, {Value: "xl", Text: "XL (out of stock)"}}, DisabledOptions("xl"), Placeholder("Pick a size")))
+	assert.Equal(t, `<select class="form-select" name="size" id="size"><option value="" disabled selected>Pick a size</option><option value="m">M</option><option value="xl" disabled>XL (out of stock)</option></select>`, html)
+}
+
+func TestConfigFromRequest(t *testing.T) {
+	req := httptest.NewRequest("POST", "/users?tab=profile", strings.NewReader("name=Ada&tags=a&tags=b"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	assert.Equal(t, url.Values{"name": {"Ada"}, "tags": {"a", "b"}, "tab": {"profile"}}, FromRequest(req))
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	mw.WriteField("name", "Grace")
+	mw.Close()
+	req = httptest.NewRequest("POST", "/upload", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	config := ConfigFromRequest(req, &TestForm{Name: "Ada"})
+	assert.True(t, config.Multipart)
+	assert.Contains(t, string(New(config).Text("name")), `value="Grace"`)
+
+	config = ConfigFromRequest(httptest.NewRequest("GET", "/users/new?name=x", nil), &TestForm{Name: "Ada"})
+	assert.Empty(t, config.OldInput)
+	assert.Contains(t, string(New(config).Text("name")), `value="Ada"`)
+}
+
+func TestClientValidationDataAttributes(t *testing.T) {
+	model := struct {
+		Email    string `form:"email" validate:"required,email,max=50"`
+		Age      int    `form:"age" validate:"gte=18"`
+		Password string `form:"password" validate:"min=8"`
+		Confirm  string `form:"confirm" validate:"eqfield=Password"`
+	}{}
+	form := New(Config{Model: model, ClientValidation: true})
+
+	email := string(form.Email("email"))
+	assert.Contains(t, email, `data-msg-required="The email field is required."`)
+	assert.Contains(t, email, `data-rule-email="true"`)
+	assert.Contains(t, email, `data-rule-maxlength="50"`)
+	assert.Contains(t, email, `data-rule-required="true"`)
+	assert.NotContains(t, email, ` maxlength=`)
+	assert.Contains(t, string(form.Number("age")), `data-rule-min="18"`)
+	assert.Contains(t, string(form.Password("password")), `data-msg-minlength="The password field must be at least 8 characters long."`)
+	assert.Contains(t, string(form.Password("confirm")), `data-rule-equalto="#password"`)
+
+	assert.NotContains(t, string(New(Config{Model: model}).Email("email")), `data-rule`)
+}
+
+type testBaseUser struct {
+	Name  string `form:"name" validate:"required"`
+	Email string `form:"email"`
+}
+
+type testAudit struct {
+	CreatedBy string `form:"created_by"`
+}
+
+func TestEmbeddedStructFields(t *testing.T) {
+	admin := struct {
+		testBaseUser
+		*testAudit
+		Level int    `form:"level"`
+		Email string `form:"email"`
+	}{testBaseUser: testBaseUser{Name: "Ada", Email: "inner@x.co"}, Level: 3, Email: "outer@x.co"}
+
+	form := New(Config{Model: admin})
+	assert.Contains(t, string(form.Text("name")), `value="Ada"`)
+	assert.Contains(t, string(form.Label("name", "Name")), `*</span>`)
+	assert.Equal(t, "outer@x.co", form.Value("email"))
+	assert.Equal(t, "", form.Value("created_by"))
+
+	auto := string(form.Auto())
+	assert.Contains(t, auto, `id="name"`)
+	assert.Contains(t, auto, `id="level"`)
+	assert.Contains(t, auto, `id="created_by"`)
+	assert.Equal(t, 1, strings.Count(auto, `id="email"`))
+	assert.Contains(t, auto, `value="outer@x.co"`)
+}
+
+type testLocaleKey struct{}
+
+func TestWithContextTranslatorAndNonce(t *testing.T) {
+	catalog := map[string]map[string]string{"tr": {"Save": "Kaydet"}, "de": {"Save": "Speichern"}}
+	base := New(Config{ContextTranslator: func(ctx context.Context, key string) string {
+		locale, _ := ctx.Value(testLocaleKey{}).(string)
+		return catalog[locale][key]
+	}})
+
+	assert.Contains(t, string(base.Submit("Save")), `>Save</button>`)
+	ctx := context.WithValue(context.Background(), testLocaleKey{}, "tr")
+	assert.Contains(t, string(base.WithContext(ctx).Submit("Save")), `>Kaydet</button>`)
+	ctx = context.WithValue(context.Background(), testLocaleKey{}, "de")
+	assert.Contains(t, string(base.WithContext(ctx).Submit("Save")), `>Speichern</button>`)
+	assert.Equal(t, context.Background(), base.Context())
+
+	form := base.WithContext(ContextWithNonce(ctx, "abc123=="))
+	assert.Equal(t, "abc123==", form.Nonce())
+	assert.Contains(t, string(form.Script("x()")), `nonce="abc123=="`)
+	assert.Equal(t, "", base.WithContext(ContextWithNonce(ctx, `"><x`)).Nonce())
+	assert.Equal(t, "cfg", New(Config{Nonce: "cfg"}).WithContext(ContextWithNonce(ctx, "abc")).Nonce())
+}
+
+func TestEscapingOfStringAndHTMLArguments(t *testing.T) {
+	form := New(Config{Errors: map[string]string{"price": `Must be < 100 & "positive"`}})
+
+	// template.HTML parçaları olduğu gibi, string değerler kaçışlanarak yazılır.
+	group := string(form.InputGroup("price", template.HTML(`<i class="bi bi-cash"></i>`), template.HTML(`&amp;`), form.Text("price")))
+	assert.Contains(t, group, `<span class="input-group-text"><i class="bi bi-cash"></i></span>`)
+	assert.Contains(t, group, `<span class="input-group-text">&amp;</span>`)
+	assert.Contains(t, group, `Must be &lt; 100 &amp; &#34;positive&#34;</div>`)
+	assert.Contains(t, string(form.FieldError("price")), `Must be &lt; 100 &amp; &#34;positive&#34;</div>`)
+	assert.Contains(t, string(form.Group("price", "Price <USD>", template.HTML(`<input data-x="&amp;">`))), `Price &lt;USD&gt;</label><input data-x="&amp;">`)
+	assert.Contains(t, string(form.Button(`<b>Go</b>`, "")), `>&lt;b&gt;Go&lt;/b&gt;</button>`)
+
+	sel := string(form.SelectGroups("c", []Optgroup{{Label: `A "&" B`, Options: []Option{{Value: `x"y`, Text: "<X>"}}}}))
+	assert.Contains(t, sel, `<optgroup label="A &#34;&amp;&#34; B"><option value="x&#34;y">&lt;X&gt;</option></optgroup>`)
+	assert.Contains(t, string(form.Select("m", map[string]string{"<k>": "<v>"})), `<option value="&lt;k&gt;">&lt;v&gt;</option>`)
+}
+
+func TestMeterAndProgress(t *testing.T) {
+	form := New(Config{Model: struct {
+		Quota float64 `form:"quota"`
+		Score int     `form:"score"`
+	}{Quota: 72.5, Score: 140}})
+
+	assert.Equal(t, `<meter id="quota" value="72.5" max="100" min="0">72.5</meter>`, string(form.Meter("quota", 0, 100)))
+	assert.Equal(t, `<meter id="quota" value="1" max="1" min="0">1</meter>`, string(form.Meter("quota", 0, 1)))
+	assert.Equal(t, `<progress id="score" value="100" max="100">100</progress>`, string(form.Progress("score", 100)))
+	assert.Equal(t, `<progress id="upload" value="3" max="10">3</progress>`, string(form.Progress("upload", 10, 3)))
+	assert.Contains(t, string(form.Meter("temp", -20, 40, -50)), `value="-20"`)
+}
+
+func TestImageButton(t *testing.T) {
+	html := string(New(Config{}).Image("map", `/img/map.png?a=1&b="2"`, `World <map>`, Attr{"width": "320", "height": "200"}))
+	assert.Equal(t, `<input type="image" name="map" alt="World &lt;map&gt;" height="200" src="/img/map.png?a=1&amp;b=&#34;2&#34;" width="320">`, html)
+}
+
+func TestIDPrefix(t *testing.T) {
+	form := New(Config{IDPrefix: "edit-user-", Errors: map[string]string{"email": "Invalid"}, Help: map[string]string{"email": "Work address"}})
+	assert.Equal(t, "edit-user-email", form.ID("email"))
+	assert.Equal(t, "edit-user-items_0_name", form.ID("items[0][name]"))
+	assert.Equal(t, "edit-user-address_city", form.ID("address.city"))
+
+	group := string(form.Group("email", "Email", form.Email("email")+form.HelpText("email", "")))
+	assert.Contains(t, group, `<label class="form-label" for="edit-user-email">`)
+	assert.Contains(t, group, `aria-describedby="edit-user-email-help edit-user-email-error"`)
+	assert.Contains(t, group, `id="edit-user-email"`)
+	assert.Contains(t, group, `<div class="form-text" id="edit-user-email-help">`)
+	assert.Contains(t, group, `id="edit-user-email-error"`)
+
+	radios := string(form.RadioGroup("role", []Option{{Value: "admin", Text: "Admin"}}))
+	assert.Contains(t, radios, `id="edit-user-role_admin"`)
+	assert.Contains(t, radios, `for="edit-user-role_admin"`)
+	assert.Contains(t, string(form.Text("name", Attr{"id": "custom"})), `id="custom"`)
+}
+
+func TestSelectOptionAttributes(t *testing.T) {
+	form := New(Config{OldInput: url.Values{"plan": {"pro"}}})
+	html := string(form.Select("plan", []AttrOption{
+		{Value: "basic", Text: "Basic", Attrs: Attr{"data-price": "9.99", "data-label": `"B" & co`}},
+		{Value: "pro", Text: "Pro", Attrs: Attr{"data-price": "19.99"}},
+		{Value: "legacy", Text: "Legacy", Attrs: Attr{"disabled": ""}},
+	}))
+	assert.Contains(t, html, `<option value="basic" data-label="&#34;B&#34; &amp; co" data-price="9.99">Basic</option>`)
+	assert.Contains(t, html, `<option value="pro" data-price="19.99" selected>Pro</option>`)
+	assert.Contains(t, html, `<option value="legacy" disabled>Legacy</option>`)
+}
+
+func TestCurrencyInput(t *testing.T) {
+	form := New(Config{Model: struct {
+		Price float64 `form:"price"`
+	}{Price: 9.5}})
+	assert.Equal(t, `<div class="input-group"><span class="input-group-text">&lt;€&gt;</span><input type="number" class="form-control" name="price" id="price" value="9.50" inputmode="decimal" step="0.01"></div>`, string(form.Currency("price", "<€>")))
+	assert.Contains(t, string(form.Currency("price", "$", Attr{"step": "1"})), `step="1"`)
+	assert.Contains(t, string(form.WithOldInput(url.Values{"price": {"9.5"}}).Currency("price", "$")), `value="9.5"`)
+
+	form = New(Config{Model: struct {
+		Price float32 `form:"price"`
+	}{Price: 19.99}})
+	assert.Contains(t, string(form.Currency("price", "$")), `value="19.99"`)
+	assert.Equal(t, "19.99", form.Value("price"))
+}
+
+func TestTimezoneSelect(t *testing.T) {
+	form := New(Config{Model: struct {
+		TZ string `form:"tz"`
+	}{TZ: "America/Argentina/Buenos_Aires"}})
+	html := string(form.TimezoneSelect("tz"))
+	assert.True(t, strings.HasPrefix(html, `<select class="form-select" name="tz" id="tz"><option value="UTC">UTC</option><optgroup label="Africa">`))
+	assert.Contains(t, html, `<option value="America/Argentina/Buenos_Aires" selected>Argentina / Buenos Aires</option>`)
+	assert.Contains(t, html, `<optgroup label="Europe">`)
+	assert.Equal(t, 1, strings.Count(html, `<optgroup label="Europe">`))
+	assert.Contains(t, html, `<option value="Europe/Istanbul">Istanbul</option>`)
+	for _, zone := range Timezones {
+		_, err := time.LoadLocation(zone)
+		assert.NoError(t, err, zone)
+	}
+}
+
+func TestClientTimezoneField(t *testing.T) {
+	form := New(Config{Nonce: "r4nd0m", IDPrefix: "f-"})
+	html := string(form.ClientTimezoneField("tz"))
+	assert.True(t, strings.HasPrefix(html, `<input type="hidden" name="tz" id="f-tz"><script nonce="r4nd0m">`))
+	assert.Contains(t, html, `document.getElementById("f-tz")`)
+	assert.Contains(t, html, `Intl.DateTimeFormat().resolvedOptions().timeZone`)
+	assert.Equal(t, `<input type="hidden" name="tz" id="f-tz">`, string(form.ClientTimezoneHidden("tz")))
+	assert.Contains(t, string(form.WithOldInput(url.Values{"tz": {"Europe/Istanbul"}}).ClientTimezoneHidden("tz")), `value="Europe/Istanbul"`)
+}
+
+func TestHTML5RequiredAttribute(t *testing.T) {
+	model := struct {
+		Name  string   `form:"name" validate:"required"`
+		Role  string   `form:"role" validate:"required"`
+		Token string   `form:"token" validate:"required"`
+		Langs []string `form:"langs" validate:"required"`
+	}{}
+	form := New(Config{Model: model, HTML5Validation: true})
+
+	assert.Contains(t, string(form.Text("name")), ` required>`)
+	assert.Contains(t, string(form.Select("role", []Option{{Value: "a", Text: "A"}})), `required`)
+	assert.NotContains(t, string(form.Hidden("token")), `required`)
+	assert.NotContains(t, string(form.Text("name", Disabled())), `required`)
+	assert.NotContains(t, string(form.CheckboxGroup("langs", []Option{{Value: "go", Text: "Go"}})), `required`)
+	assert.NotContains(t, string(New(Config{Model: model}).Text("name")), `required`)
+}
+
+func TestTextForBindsToAnotherModel(t *testing.T) {
+	type address struct {
+		City string `form:"city"`
+	}
+	form := New(Config{Model: TestForm{Name: "Ada"}, Errors: map[string]string{"city": "Unknown city"}})
+	other := address{City: "London"}
+
+	html := string(form.TextFor(other, "city"))
+	assert.Contains(t, html, `value="London"`)
+	assert.Contains(t, html, `is-invalid`)
+	assert.Equal(t, "London", form.ValueFor(&other, "city"))
+	assert.Equal(t, "", form.Value("city"))
+	assert.Contains(t, string(form.InputFor(other, "search", "city")), `type="search"`)
+
+	form = form.WithOldInput(url.Values{"city": {"Paris"}})
+	assert.Contains(t, string(form.TextFor(other, "city")), `value="Paris"`)
+}
+
+func TestTextNodeExposesAttributes(t *testing.T) {
+	form := New(Config{Model: TestForm{Name: "Ada"}, Errors: map[string]string{"name": "Too short"}})
+
+	node := form.TextNode("name", Precision(2))
+	assert.Equal(t, "input", node.Tag)
+	assert.Equal(t, "Ada", node.Attributes["value"])
+	assert.Equal(t, "text", node.Attributes["type"])
+	assert.Contains(t, node.Attributes["class"], "is-invalid")
+	invalid, ok := node.Attr("aria-invalid")
+	assert.True(t, ok)
+	assert.Equal(t, "true", invalid)
+	for k := range node.Attributes {
+		assert.False(t, strings.HasPrefix(k, directivePrefix), k)
+	}
+	assert.Equal(t, form.Text("name", Precision(2)), node.HTML())
+
+	list := Element{Tag: "ul", Children: []Element{{Tag: "li", Text: "a<b"}}}
+	assert.Equal(t, template.HTML("<ul><li>a&lt;b</li></ul>"), list.HTML())
+}
+
+func TestMapModel(t *testing.T) {
+	form := New(Config{Model: map[string]interface{}{
+		"name":   "Ada",
+		"age":    36,
+		"active": true,
+		"tags":   []string{"go", "html"},
+		"items":  []interface{}{map[string]interface{}{"title": "First"}},
+		"note":   nil,
+	}})
+
+	assert.Contains(t, string(form.Text("name")), `value="Ada"`)
+	assert.Contains(t, string(form.Number("age")), `value="36"`)
+	assert.Contains(t, string(form.Checkbox("active", "1")), "checked")
+	assert.Equal(t, []string{"go", "html"}, form.Values("tags"))
+	assert.Equal(t, "First", form.Value("items[0][title]"))
+	assert.Equal(t, "", form.Value("note"))
+	assert.Equal(t, "", form.Value("missing"))
+
+	typed := New(Config{Model: map[string]string{"city": "Paris"}})
+	assert.Equal(t, "Paris", typed.Value("city"))
+}
+
+func TestOmitEmptyValue(t *testing.T) {
+	model := TestForm{Name: ""}
+	assert.Contains(t, string(New(Config{Model: model}).Text("name")), `value=""`)
+
+	form := New(Config{Model: model, OmitEmptyValue: true})
+	assert.NotContains(t, string(form.Text("name")), "value=")
+	assert.Contains(t, string(form.Text("name", Attr{"value": ""})), `value=""`)
+
+	form = New(Config{Model: TestForm{Name: "Ada"}, OmitEmptyValue: true})
+	assert.Contains(t, string(form.Text("name")), `value="Ada"`)
+	form = form.WithOldInput(url.Values{"name": {""}})
+	assert.NotContains(t, string(form.Text("name")), "value=")
+}
+
+func TestTagsInput(t *testing.T) {
+	type post struct {
+		Tags []string `form:"tags"`
+	}
+	form := New(Config{Model: post{Tags: []string{"go", "html"}}})
+	html := string(form.Tags("tags"))
+	assert.Contains(t, html, `data-role="tagsinput"`)
+	assert.Contains(t, html, `value="go,html"`)
+	assert.Contains(t, html, `type="text"`)
+
+	form = form.WithOldInput(url.Values{"tags": {"a, b"}})
+	assert.Contains(t, string(form.Tags("tags")), `value="a, b"`)
+
+	assert.Equal(t, []string{"go", "html", "css"}, ParseTags(" go,html,, css ,go"))
+	assert.Nil(t, ParseTags(""))
+}
+
+func TestExplicitValueOverride(t *testing.T) {
+	form := New(Config{
+		Model:    TestForm{Name: "Model"},
+		OldInput: url.Values{"name": {"Old"}},
+		Errors:   map[string]string{"name": "Required"},
+	})
+
+	html := string(form.TextValue("name", "Given"))
+	assert.Contains(t, html, `value="Given"`)
+	assert.Contains(t, html, "is-invalid")
+	assert.Contains(t, string(form.TextValue("name", "Given", Attr{"value": "ignored"})), `value="Given"`)
+	assert.Contains(t, string(form.TextValue("name", "")), `value=""`)
+	assert.Equal(t, template.HTML(`<input type="hidden" name="token" id="token" value="abc">`), form.HiddenValue("token", "abc"))
+	assert.Contains(t, string(form.InputValue("email", "name", "a@b.c")), `type="email"`)
+}
+
+func TestFieldset(t *testing.T) {
+	form := New(Config{})
+	content := form.Text("city")
+
+	assert.Equal(t,
+		template.HTML(`<fieldset><legend>Address &amp; contact</legend>`+string(content)+`</fieldset>`),
+		form.Fieldset("Address & contact", content))
+	assert.Equal(t,
+		template.HTML(`<fieldset class="mb-3" disabled>`+string(content)+`</fieldset>`),
+		form.Fieldset("", content, Disabled(), Attr{"class": "mb-3"}))
+}
+
+func TestStepWizard(t *testing.T) {
+	form := New(Config{Step: 2})
+	assert.Equal(t, 2, form.CurrentStep())
+	assert.Equal(t,
+		template.HTML(`<input type="hidden" name="_step" id="_step" value="2"><progress id="_step_progress" value="2" max="3">2</progress>`),
+		form.Step(0, 3))
+	assert.Equal(t, template.HTML(`<input type="hidden" name="_step" id="_step" value="1">`), form.Step(1, 0))
+
+	submitted := New(Config{OldInput: url.Values{"_step": {"3"}, "name": {"Ada"}}})
+	assert.Equal(t, 3, submitted.CurrentStep())
+	assert.Contains(t, string(submitted.Text("name")), `value="Ada"`)
+	assert.Equal(t, 1, New(Config{}).CurrentStep())
+}
+
+func TestAttributeOrderIsDeterministic(t *testing.T) {
+	form := New(Config{Model: TestForm{Name: "Ada"}, Errors: map[string]string{"name": "Required"}})
+	attrs := Attr{"data-z": "1", "autofocus": "", "data-a": "2", "title": "Name", "maxlength": "10", "readonly": ""}
+
+	first := form.Text("name", attrs)
+	assert.Equal(t, template.HTML(`<input type="text" class="form-control is-invalid" name="name" id="name" value="Ada" aria-describedby="name-error" aria-invalid="true" autofocus data-a="2" data-z="1" maxlength="10" readonly title="Name">`), first)
+	for i := 0; i < 50; i++ {
+		assert.Equal(t, first, form.Text("name", attrs))
+	}
+
+	options := []AttrOption{{Value: "a", Text: "A", Attrs: Attr{"title": "t", "data-x": "1"}}}
+	assert.Contains(t, string(New(Config{OldInput: url.Values{"o": {"a"}}}).Select("o", options)), `<option value="a" data-x="1" selected title="t">A</option>`)
+}
+
+func TestTrackChanges(t *testing.T) {
+	model := struct {
+		Name  string   `form:"name"`
+		Bio   string   `form:"bio"`
+		Roles []string `form:"roles"`
+	}{Name: "Ada", Bio: "x", Roles: []string{"a", "b"}}
+	form := New(Config{Model: &model, TrackChanges: true})
+
+	assert.Contains(t, string(form.Text("name")), `<input type="hidden" name="original[name]" value="Ada">`)
+	assert.Contains(t, string(form.Textarea("bio", 0, 0)), `</textarea><input type="hidden" name="original[bio]" value="x">`)
+	roles := string(form.MultiSelect("roles", []Option{{Value: "a", Text: "A"}, {Value: "b", Text: "B"}}))
+	assert.Contains(t, roles, `<input type="hidden" name="original[roles][]" value="a"><input type="hidden" name="original[roles][]" value="b">`)
+	assert.NotContains(t, string(form.Password("name")), "original")
+	assert.NotContains(t, string(New(Config{Model: &model}).Text("name")), "original")
+
+	resubmitted := form.WithOldInput(url.Values{"name": {"Grace"}, "original[name]": {"Ada"}})
+	model.Name = "Changed elsewhere"
+	assert.Contains(t, string(resubmitted.Text("name")), `name="original[name]" value="Ada"`)
+	assert.Equal(t, template.HTML(`<input type="hidden" name="original[bio]" value="x">`), New(Config{Model: &model}).OriginalField("bio"))
+
+	changes := DiffChanges(url.Values{
+		"name": {"Grace"}, "original[name]": {"Ada"},
+		"bio": {"x"}, "original[bio]": {"x"},
+		"roles[]": {"a"}, "original[roles][]": {"a", "b"},
+		"items[0][qty]": {"2"}, "original[items[0][qty]]": {"1"},
+		"note": {""}, "unrelated": {"1"},
+	})
+	assert.Equal(t, map[string]string{"name": "Grace", "roles": "a", "items[0][qty]": "2"}, changes)
+}
+
+func TestTrackChangesRoundTripsRenderedValues(t *testing.T) {
+	model := struct {
+		Price  float64   `form:"price"`
+		Born   time.Time `form:"born"`
+		Volume int       `form:"volume"`
+	}{Price: 9.9, Born: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Volume: 500}
+	form := New(Config{Model: &model, TrackChanges: true})
+	rendered := string(form.Currency("price", "$") + form.DateText("born", "02/01/2006") + form.Range("volume", 0, 100, 0))
+	assert.Contains(t, rendered, `name="original[price]" value="9.90"`)
+	assert.Contains(t, rendered, `name="original[born]" value="02/01/2024"`)
+	assert.Contains(t, rendered, `name="original[volume]" value="100"`)
+
+	// Dokunulmadan gönderilen form, render edilen değerleri olduğu gibi geri yollar.
+	posted := url.Values{}
+	nameAttr, valueAttr := regexp.MustCompile(` name="([^"]*)"`), regexp.MustCompile(` value="([^"]*)"`)
+	for _, tag := range regexp.MustCompile(`<input [^>]*>`).FindAllString(rendered, -1) {
+		posted.Add(nameAttr.FindStringSubmatch(tag)[1], valueAttr.FindStringSubmatch(tag)[1])
+	}
+	assert.Empty(t, DiffChanges(posted))
+}
+
+func TestTrackChangesWithWidgetTemplates(t *testing.T) {
+	form := New(Config{
+		Model: struct {
+			Role string `form:"role"`
+			Bio  string `form:"bio"`
+		}{Role: "admin", Bio: "x"},
+		TrackChanges: true,
+		Templates: map[string]*template.Template{
+			"select":   template.Must(template.New("select").Parse(`<x-select name="{{.Name}}"></x-select>`)),
+			"textarea": template.Must(template.New("textarea").Parse(`<x-textarea name="{{.Name}}"></x-textarea>`)),
+		},
+	})
+	assert.Equal(t, `<x-select name="role"></x-select><input type="hidden" name="original[role]" value="admin">`, string(form.Select("role", []Option{{Value: "admin", Text: "Admin"}})))
+	assert.Equal(t, `<x-textarea name="bio"></x-textarea><input type="hidden" name="original[bio]" value="x">`, string(form.Textarea("bio", 0, 0)))
+}
+
+func TestDateText(t *testing.T) {
+	birthday := time.Date(1990, 4, 2, 0, 0, 0, 0, time.UTC)
+	model := struct {
+		Birthday time.Time  `form:"birthday"`
+		Joined   *time.Time `form:"joined"`
+		Empty    time.Time  `form:"empty"`
+	}{Birthday: birthday, Joined: &birthday}
+	form := New(Config{Model: &model})
+
+	assert.Equal(t, template.HTML(`<input type="text" class="form-control" name="birthday" id="birthday" value="02/04/1990">`), form.DateText("birthday", "02/01/2006"))
+	assert.Contains(t, string(form.DateText("joined", "Jan 2, 2006")), `value="Apr 2, 1990"`)
+	assert.Contains(t, string(form.DateText("empty", "02/01/2006")), `value=""`)
+
+	form = form.WithOldInput(url.Values{"birthday": {"31/02/1990"}})
+	assert.Contains(t, string(form.DateText("birthday", "02/01/2006")), `value="31/02/1990"`)
+}
+
+func TestPasswordConfirmation(t *testing.T) {
+	form := New(Config{OldInput: url.Values{"password": {"s3cret"}, "password_confirmation": {"s3cret"}}, Errors: map[string]string{"password_confirmation": "Does not match"}})
+	password, confirm := form.PasswordConfirmation("password", "password_confirmation", Attr{"minlength": "8"})
+
+	assert.Equal(t, template.HTML(`<input type="password" class="form-control" name="password" id="password" autocomplete="new-password" minlength="8">`), password)
+	assert.Contains(t, string(confirm), `name="password_confirmation"`)
+	assert.Contains(t, string(confirm), `is-invalid`)
+	assert.NotContains(t, string(password)+string(confirm), "s3cret")
+
+	assert.True(t, PasswordsMatch(url.Values{"p": {"abc"}, "c": {"abc"}}, "p", "c"))
+	assert.False(t, PasswordsMatch(url.Values{"p": {"abc"}, "c": {"abd"}}, "p", "c"))
+	assert.False(t, PasswordsMatch(url.Values{"p": {"abc"}}, "p", "c"))
+}
+
+func TestRangeOptions(t *testing.T) {
+	assert.Equal(t, []Option{{Value: "1", Text: "1"}, {Value: "2", Text: "2"}, {Value: "3", Text: "3"}}, RangeOptions(1, 3, 1))
+	assert.Equal(t, []Option{{Value: "0", Text: "0"}, {Value: "5", Text: "5"}, {Value: "10", Text: "10"}}, RangeOptions(0, 11, 5))
+	assert.Equal(t, []Option{{Value: "2025", Text: "2025"}, {Value: "2024", Text: "2024"}, {Value: "2023", Text: "2023"}}, RangeOptions(2025, 2023, -1))
+	assert.Equal(t, []Option{{Value: "3", Text: "3"}, {Value: "2", Text: "2"}}, RangeOptions(3, 2, 0))
+	assert.Equal(t, []Option{{Value: "7", Text: "7"}}, RangeOptions(7, 7, 1))
+	assert.Nil(t, RangeOptions(1, 5, -1))
+
+	form := New(Config{Model: struct {
+		Qty int `form:"qty"`
+	}{Qty: 2}})
+	assert.Contains(t, string(form.Select("qty", RangeOptions(1, 3, 1))), `<option value="2" selected>2</option>`)
+}
+
+func TestFormEnctype(t *testing.T) {
+	cases := []struct {
+		config Config
+		open   string
+	}{
+		{Config{Action: "/a"}, `<form method="POST" action="/a">`},
+		{Config{Action: "/a", Multipart: true}, `<form method="POST" action="/a" enctype="multipart/form-data">`},
+		{Config{Action: "/a", Enctype: "application/x-www-form-urlencoded"}, `<form method="POST" action="/a" enctype="application/x-www-form-urlencoded">`},
+		{Config{Action: "/a", Enctype: "text/plain", Multipart: true}, `<form method="POST" action="/a" enctype="text/plain">`},
+		{Config{Action: "/a", Enctype: "multipart/form-data"}, `<form method="POST" action="/a" enctype="multipart/form-data">`},
+		{Config{Action: "/a", Method: "GET"}, `<form method="GET" action="/a">`},
+		{Config{Action: "/a", Method: "GET", Enctype: "application/x-www-form-urlencoded"}, `<form method="GET" action="/a" enctype="application/x-www-form-urlencoded">`},
+		{Config{Action: "/a", Method: "GET", Multipart: true}, `<form method="POST" action="/a" enctype="multipart/form-data">`},
+		{Config{Action: "/a", Method: "GET", Enctype: "multipart/form-data"}, `<form method="POST" action="/a" enctype="multipart/form-data">`},
+	}
+	for _, c := range cases {
+		assert.True(t, strings.HasPrefix(string(New(c.config).Open()), c.open+"\n"), c.open)
+	}
+}
+
+func TestGroupClass(t *testing.T) {
+	input := template.HTML(`<input>`)
+	label := `<label class="form-label" for="city">City</label>`
+
+	form := New(Config{})
+	assert.Equal(t, template.HTML(`<div class="form-group mb-3">`+label+`<input></div>`), form.Group("city", "City", input))
+	assert.Equal(t, template.HTML(`<div class="col-md-6">`+label+`<input></div>`), form.Group("city", "City", input, Attr{"class": "col-md-6"}))
+	assert.Equal(t, template.HTML(label+`<input>`), form.Group("city", "City", input, Attr{"class": ""}))
+
+	form = New(Config{GroupClass: Class("col-md-6 mb-3")})
+	assert.True(t, strings.HasPrefix(string(form.Group("city", "City", input)), `<div class="col-md-6 mb-3">`))
+	assert.True(t, strings.HasPrefix(string(form.Field("city").Label("City").GroupClass("row").Render()), `<div class="row">`))
+
+	form = New(Config{GroupClass: Class(""), Model: struct {
+		Active bool `form:"active"`
+	}{}})
+	assert.Equal(t, template.HTML(label+`<input>`), form.Group("city", "City", input))
+	assert.True(t, strings.HasPrefix(string(form.Auto()), `<div class="form-check">`))
+}
+
+func TestFormLayouts(t *testing.T) {
+	input := template.HTML(`<input>`)
+
+	form := New(Config{Action: "/s", Layout: LayoutHorizontal, Errors: map[string]string{"city": "Required"}})
+	assert.Equal(t,
+		template.HTML(`<div class="row mb-3"><label class="col-sm-3 col-form-label" for="city">City</label><div class="col-sm-9"><input><div class="invalid-feedback" id="city-error">Required</div></div></div>`),
+		form.Group("city", "City", input))
+	assert.True(t, strings.HasPrefix(string(form.Open()), `<form method="POST" action="/s">`))
+
+	form = New(Config{Action: "/s", Method: "GET", Layout: LayoutInline})
+	assert.True(t, strings.HasPrefix(string(form.Open()), `<form method="GET" action="/s" class="row row-cols-lg-auto g-3 align-items-center">`))
+	assert.Equal(t,
+		template.HTML(`<div class="col-12"><label class="visually-hidden" for="q">Search</label><input></div>`),
+		form.Group("q", "Search", input))
+
+	form = New(Config{Layout: LayoutHorizontal, Theme: &TailwindTheme})
+	assert.True(t, strings.HasPrefix(string(form.Group("city", "City", input)), `<div class="mb-4 grid grid-cols-12 items-center gap-4"><label class="col-span-3`))
+}
+
+func TestListBox(t *testing.T) {
+	options := []Option{{Value: "go", Text: "Go"}, {Value: "rust", Text: "Rust"}, {Value: "zig", Text: "Zig"}}
+	model := struct {
+		Langs []string `form:"langs" validate:"required"`
+	}{Langs: []string{"go", "zig"}}
+	form := New(Config{Model: &model, HTML5Validation: true})
+
+	assert.Equal(t,
+		template.HTML(`<select class="form-select" name="langs[]" id="langs" multiple required size="5"><option value="go" selected>Go</option><option value="rust">Rust</option><option value="zig" selected>Zig</option></select>`),
+		form.ListBox("langs", options, 5, Placeholder("Pick")))
+
+	form = New(Config{OldInput: url.Values{"langs[]": {"rust"}}})
+	html := string(form.MultiSelect("langs", options, Size(3), Required()))
+	assert.Contains(t, html, `multiple required size="3"`)
+	assert.Contains(t, html, `<option value="rust" selected>Rust</option>`)
+	assert.NotContains(t, html, `value="go" selected`)
+}
+
+func TestShowIfAndHideIf(t *testing.T) {
+	form := New(Config{Nonce: "r4nd0m"})
+
+	html := string(form.Group("state", "State", form.Text("state"), ShowIf("country", "US", `"CA"`)))
+	assert.True(t, strings.HasPrefix(html, `<div class="form-group mb-3" data-show-if="country" data-show-values="[&#34;US&#34;,&#34;\&#34;CA\&#34;&#34;]"><label`))
+	assert.Contains(t, string(form.Text("vat", HideIf("type", "personal"))), `data-hide-if="type" data-hide-values="[&#34;personal&#34;]"`)
+	assert.Equal(t, Attr{"data-show-if": "x", "data-show-values": "[]"}, ShowIf("x"))
+
+	script := string(form.VisibilityScript())
+	assert.True(t, strings.HasPrefix(script, `<script nonce="r4nd0m">(function(){`))
+	assert.Contains(t, script, `data-show-if`)
+}
+
+func TestRecaptcha(t *testing.T) {
+	form := New(Config{Nonce: "r4nd0m"})
+	assert.Equal(t, template.HTML(`<div class="g-recaptcha" data-sitekey="site&#34;key" data-theme="dark"></div>`), form.Recaptcha(`site"key`, Attr{"data-theme": "dark"}))
+	assert.Equal(t, template.HTML(`<script async defer nonce="r4nd0m" src="https://www.google.com/recaptcha/api.js"></script>`), form.RecaptchaScript())
+
+	var got url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		got = r.PostForm
+		fmt.Fprintf(w, `{"success": %t}`, r.PostForm.Get("response") == "good")
+	}))
+	defer server.Close()
+
+	ok, err := verifyRecaptcha(context.Background(), server.Client(), server.URL, "secret", "good", "10.0.0.1")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, url.Values{"secret": {"secret"}, "response": {"good"}, "remoteip": {"10.0.0.1"}}, got)
+
+	ok, err = verifyRecaptcha(context.Background(), server.Client(), server.URL, "secret", "bad", "")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	got = nil
+	ok, err = VerifyRecaptcha(context.Background(), "secret", "", "")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, got)
+
+	_, err = verifyRecaptcha(context.Background(), server.Client(), server.URL+"/missing\x7f", "secret", "good", "")
+	assert.Error(t, err)
+}
+
+func TestFormIDAndFormAttribute(t *testing.T) {
+	form := New(Config{Action: "/save", FormID: "profile"})
+	assert.True(t, strings.HasPrefix(string(form.Open()), `<form method="POST" action="/save" id="profile">`))
+	assert.NotContains(t, string(form.Text("name")), `form=`)
+
+	form = New(Config{Action: "/save", FormID: "profile", FormAttribute: true})
+	assert.Contains(t, string(form.Text("name")), ` form="profile"`)
+	assert.Contains(t, string(form.Textarea("bio", 0, 0)), ` form="profile"`)
+	assert.Contains(t, string(form.Select("role", []Option{{Value: "a", Text: "A"}})), ` form="profile"`)
+	assert.Equal(t, template.HTML(`<button type="submit" class="btn btn-primary" form="profile">Save</button>`), form.Submit("Save"))
+	assert.Contains(t, string(form.Button("Preview", "")), ` form="profile"`)
+	assert.Contains(t, string(form.Honeypot("website")), ` form="profile"`)
+	assert.Equal(t, 2, strings.Count(string(form.Checkbox("active", "1")), `form="profile"`))
+	assert.Contains(t, string(form.Text("name", Attr{"form": "other"})), ` form="other"`)
+	assert.NotContains(t, string(New(Config{FormAttribute: true}).Text("name")), `form=`)
+}
+
+func TestDebugDump(t *testing.T) {
+	form := New(Config{
+		Model:    TestForm{Name: "Ada", Email: "ada@example.com"},
+		OldInput: url.Values{"email": {"typo@"}, "_csrf": {"t"}, "extra[]": {"a", "b"}},
+		Errors:   map[string]string{"email": "Invalid email", "ghost": "No such field"},
+	})
+	dump := form.Debug()
+	lines := strings.Split(strings.TrimSpace(dump), "\n")
+
+	assert.Regexp(t, `^FIELD\s+SOURCE\s+VALUE\s+ERROR$`, lines[0])
+	assert.Regexp(t, `(?m)^email\s+old input\s+"typo@"\s+Invalid email$`, dump)
+	assert.Regexp(t, `(?m)^name\s+model\s+"Ada"\s+-$`, dump)
+	assert.Regexp(t, `(?m)^extra\s+old input\s+"a, b"\s+-$`, dump)
+	assert.Regexp(t, `(?m)^ghost\s+-\s+-\s+No such field$`, dump)
+	assert.NotContains(t, dump, "_csrf")
+
+	mapped := New(Config{Model: map[string]interface{}{"city": "Paris", "zip": nil, "address": map[string]interface{}{"street": nil}}}).Debug()
+	assert.Regexp(t, `(?m)^city\s+model\s+"Paris"\s+-$`, mapped)
+	assert.Regexp(t, `(?m)^zip\s+model\s+""\s+-$`, mapped)
+	assert.True(t, modelHasField(map[string]interface{}{"address": map[string]interface{}{"street": nil}}, "address.street"))
+	assert.False(t, modelHasField(map[string]interface{}{"address": map[string]interface{}{}}, "address.street"))
+}
+
+func TestErrorFeedbackTag(t *testing.T) {
+	theme := TailwindTheme
+	theme.ErrorInputClass = "border-red-500"
+	theme.ErrorMessageClass = "text-red-500"
+	theme.ErrorFeedbackClass = "text-red-500 text-sm"
+	theme.ErrorFeedbackTag = "p"
+	form := New(Config{Theme: &theme, FieldErrors: map[string][]string{"name": {"Required", "Too short"}}})
+
+	assert.Equal(t, template.HTML(`<p class="text-red-500" id="name-error">Required</p>`), form.FieldError("name"))
+	assert.Equal(t, template.HTML(`<p class="text-red-500" id="name-error">Required</p><p class="text-red-500">Too short</p>`), form.Errors("name"))
+	assert.Contains(t, string(form.Group("name", "Name", form.Text("name"))), `<p class="text-red-500 text-sm" id="name-error">Required</p></div>`)
+	assert.Contains(t, string(form.Text("name")), `border-red-500`)
+
+	theme.ErrorFeedbackTag = ""
+	assert.Equal(t, template.HTML(`<div class="text-red-500" id="name-error">Required</div>`), New(Config{Theme: &theme, Errors: map[string]string{"name": "Required"}}).FieldError("name"))
+}
+
+func TestWeekdayAndMonthOptions(t *testing.T) {
+	en := WeekdayOptions("en")
+	assert.Len(t, en, 7)
+	assert.Equal(t, Option{Value: "0", Text: "Sunday"}, en[0])
+	assert.Equal(t, Option{Value: "6", Text: "Saturday"}, en[6])
+
+	tr := WeekdayOptions("tr-TR")
+	assert.Equal(t, Option{Value: "1", Text: "Pazartesi"}, tr[0])
+	assert.Equal(t, Option{Value: "0", Text: "Pazar"}, tr[6])
+	assert.Equal(t, Option{Value: "3", Text: "mercredi"}, WeekdayOptions("fr_FR")[2])
+
+	months := MonthOptions("de")
+	assert.Len(t, months, 12)
+	assert.Equal(t, Option{Value: "3", Text: "März"}, months[2])
+	assert.Equal(t, Option{Value: "12", Text: "diciembre"}, MonthOptions("ES")[11])
+	assert.Equal(t, MonthOptions("en"), MonthOptions("xx"))
+
+	pt := MonthOptionsFrom([12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"})
+	assert.Equal(t, Option{Value: "3", Text: "março"}, pt[2])
+	days := WeekdayOptionsFrom([7]string{"domingo", "segunda", "terça", "quarta", "quinta", "sexta", "sábado"})
+	assert.Equal(t, Option{Value: "0", Text: "domingo"}, days[0])
+	assert.Equal(t, Option{Value: "6", Text: "sábado"}, days[6])
+
+	form := New(Config{Model: struct {
+		Month time.Month `form:"month"`
+	}{Month: time.May}})
+	assert.Contains(t, string(form.Select("month", MonthOptions("en"))), `<option value="5" selected>May</option>`)
+	form = New(Config{Model: struct {
+		Day time.Weekday `form:"day"`
+	}{Day: time.Friday}})
+	assert.Contains(t, string(form.Select("day", WeekdayOptions("de"))), `<option value="5" selected>Freitag</option>`)
+}
+
+func TestOptionDisabledField(t *testing.T) {
+	form := New(Config{Model: struct {
+		Size string `form:"size"`
+	}{Size: "xl"}})
+	html := string(form.Select("size", []Option{{Value: "m", Text: "M"}, {Value: "xl", Text: "XL", Disabled: true}}))
+	assert.Equal(t, `<select class="form-select" name="size" id="size"><option value="m">M</option><option value="xl" disabled>XL</option></select>`, html)
+
+	grouped := string(form.Select("size", []Option{
+		{Value: "any", Text: "Any"},
+		{Value: "s", Text: "S", Group: "Small"},
+		{Value: "xs", Text: "XS", Group: "Small", Disabled: true},
+		{Value: "xl", Text: "XL", Group: "Large"},
+	}))
+	assert.Contains(t, grouped, `<option value="any">Any</option><optgroup label="Small"><option value="s">S</option><option value="xs" disabled>XS</option></optgroup><optgroup label="Large"><option value="xl" selected>XL</option></optgroup>`)
+
+	groups := string(form.SelectGroups("size", []OptGroup{{Label: "All", Options: []Option{{Value: "xl", Text: "XL", Disabled: true}}}}))
+	assert.Contains(t, groups, `<option value="xl" disabled>XL</option>`)
+}
@@ -0,0 +1,73 @@
+package builder
+
+import (
+	"strconv"
+	"strings"
+)
+
+// weekdayNames, WeekdayOptions'ın kullandığı gün adlarıdır; Pazar'dan başlayarak time.Weekday sırasındadır.
+// Başka diller için WeekdayOptionsFrom kullanılır.
+var weekdayNames = map[string][7]string{
+	"en": {"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	"tr": {"Pazar", "Pazartesi", "Salı", "Çarşamba", "Perşembe", "Cuma", "Cumartesi"},
+	"de": {"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	"fr": {"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	"es": {"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+}
+
+// monthNames, MonthOptions'ın kullandığı ay adlarıdır; Ocak'tan başlar. Başka diller için MonthOptionsFrom kullanılır.
+var monthNames = map[string][12]string{
+	"en": {"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	"tr": {"Ocak", "Şubat", "Mart", "Nisan", "Mayıs", "Haziran", "Temmuz", "Ağustos", "Eylül", "Ekim", "Kasım", "Aralık"},
+	"de": {"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	"fr": {"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	"es": {"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+}
+
+// sundayFirst, haftanın Pazar günü başladığı dillerdir; diğerlerinde seçenekler Pazartesi'den başlar.
+var sundayFirst = map[string]bool{"en": true}
+
+// WeekdayOptions, verilen dilde haftanın günlerini seçenek olarak döndürür. Değerler time.Weekday
+// numaralarıdır ("0" Pazar); "tr-TR" gibi bölge ekleri yok sayılır, bilinmeyen diller İngilizce olur.
+func WeekdayOptions(locale string) []Option {
+	lang := calendarLanguage(locale, func(l string) bool { _, ok := weekdayNames[l]; return ok })
+	options := WeekdayOptionsFrom(weekdayNames[lang])
+	if !sundayFirst[lang] {
+		options = append(options[1:], options[0])
+	}
+	return options
+}
+
+// WeekdayOptionsFrom, time.Weekday sırasında (Pazar'dan başlayarak) verilen adlardan gün seçenekleri üretir.
+func WeekdayOptionsFrom(names [7]string) []Option {
+	options := make([]Option, 0, len(names))
+	for i, name := range names {
+		options = append(options, Option{Value: strconv.Itoa(i), Text: name})
+	}
+	return options
+}
+
+// MonthOptions, verilen dilde ayları "1"den "12"ye kadar değerlerle seçenek olarak döndürür.
+func MonthOptions(locale string) []Option {
+	return MonthOptionsFrom(monthNames[calendarLanguage(locale, func(l string) bool { _, ok := monthNames[l]; return ok })])
+}
+
+// MonthOptionsFrom, Ocak'tan başlayarak verilen adlardan "1"den "12"ye kadar değerli ay seçenekleri üretir.
+func MonthOptionsFrom(names [12]string) []Option {
+	options := make([]Option, 0, len(names))
+	for i, name := range names {
+		options = append(options, Option{Value: strconv.Itoa(i + 1), Text: name})
+	}
+	return options
+}
+
+func calendarLanguage(locale string, known func(string) bool) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if known(locale) {
+		return locale
+	}
+	if i := strings.IndexAny(locale, "-_"); i > 0 && known(locale[:i]) {
+		return locale[:i]
+	}
+	return "en"
+}
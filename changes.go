@@ -0,0 +1,107 @@
+package builder
+
+import (
+	"html/template"
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// originalPrefix, TrackChanges ile eklenen ilk değer alanlarının ad önekidir: "email" için "original[email]".
+const originalPrefix = "original["
+
+// untrackedTypes, TrackChanges açıkken ilk değeri eklenmeyen input tipleridir. Onay kutuları ve radio'lar
+// gönderilen değer ile model değeri farklı biçimde olduğundan gerekirse OriginalField ile elle eklenir.
+var untrackedTypes = map[string]bool{
+	"hidden": true, "password": true, "file": true, "checkbox": true, "radio": true,
+	"submit": true, "reset": true, "button": true, "image": true,
+}
+
+// OriginalField, alanın modeldeki ilk değerini original[name] adlı gizli alanlarda taşır. Form doğrulama hatasıyla
+// yeniden render edildiğinde gönderilmiş ilk değer korunur; böylece DiffChanges her zaman kayıttaki değerle karşılaştırır.
+func (b *Builder) OriginalField(name string) template.HTML {
+	return renderHTML(func(w io.Writer) error {
+		hw := &htmlWriter{w: w}
+		b.writeOriginal(hw, "", name, nil)
+		return hw.err
+	})
+}
+
+// writeOriginal, alanın ilk değerini yazar. rendered, alanın render edilen değeridir; alan için eski girdi yoksa
+// bu değer modelden gelmiştir ve hassasiyet, tarih biçimi ya da aralık sıkıştırması uygulanmış hâliyle kullanılır.
+// Böylece dokunulmadan gönderilen alanlar DiffChanges'te değişmiş görünmez.
+func (b *Builder) writeOriginal(hw *htmlWriter, typ, name string, rendered []string) {
+	cleanName := strings.TrimSuffix(name, "[]")
+	fieldName := originalPrefix + cleanName + "]"
+	if strings.HasSuffix(name, "[]") {
+		fieldName += "[]"
+	}
+	values, submitted := b.oldInput[fieldName]
+	if _, edited := b.oldInput[name]; !submitted && rendered != nil && !edited {
+		values = rendered
+	} else if !submitted {
+		values = b.originalValues(typ, cleanName)
+	}
+	if len(values) == 0 {
+		values = []string{""}
+	}
+	for _, v := range values {
+		hw.tag("input", b.applyFormAttribute(map[string]string{"type": "hidden", "name": fieldName, "value": v}))
+	}
+}
+
+// originalValues, değeri eski girdiye bakmadan yalnızca modelden ve input'un göstereceği biçimde çözer.
+func (b *Builder) originalValues(typ, name string) []string {
+	if b.model == nil {
+		return nil
+	}
+	value := getFieldFromModel(b.model, name)
+	if value == nil {
+		return nil
+	}
+	if t, ok := value.(time.Time); ok && typ == "" {
+		if t.IsZero() {
+			return nil
+		}
+		return []string{t.Format(time.RFC3339)}
+	}
+	if val := reflect.ValueOf(value); val.Kind() == reflect.Slice {
+		values := make([]string, 0, val.Len())
+		for i := 0; i < val.Len(); i++ {
+			values = append(values, formatValue(typ, val.Index(i).Interface()))
+		}
+		return values
+	}
+	return []string{formatValue(typ, value)}
+}
+
+// DiffChanges, gönderilen formda original[...] alanlarıyla karşılaştırıldığında değişmiş alanları yeni
+// değerleriyle döndürür. Çok değerli alanların değerleri virgülle birleştirilir.
+func DiffChanges(values url.Values) map[string]string {
+	changes := make(map[string]string)
+	for key, original := range values {
+		if !strings.HasPrefix(key, originalPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(key, originalPrefix)
+		multiple := strings.HasSuffix(name, "][]")
+		name = strings.TrimSuffix(strings.TrimSuffix(name, "[]"), "]")
+		current := values[name]
+		if multiple {
+			current = values[name+"[]"]
+		}
+		if joinValues(current) != joinValues(original) {
+			changes[name] = joinValues(current)
+		}
+	}
+	return changes
+}
+
+func joinValues(values []string) string {
+	if len(values) == 1 && values[0] == "" {
+		return ""
+	}
+	return strings.Join(values, ",")
+}
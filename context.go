@@ -0,0 +1,27 @@
+package builder
+
+import "context"
+
+type nonceContextKey struct{}
+
+// ContextWithNonce, isteğe özel CSP nonce değerini context'e ekler. WithContext ile bu context'i alan
+// builder, Config.Nonce verilmemişse bu değeri kullanır.
+func ContextWithNonce(ctx context.Context, nonce string) context.Context {
+	return context.WithValue(ctx, nonceContextKey{}, nonce)
+}
+
+// WithContext, istek context'i bağlanmış bir kopya döndürür. Context şu noktalarda okunur:
+// Config.ContextTranslator çevirilerde, ContextWithNonce ile eklenen nonce ise Nonce ve Script'te.
+func (b *Builder) WithContext(ctx context.Context) *Builder {
+	clone := b.Clone()
+	clone.ctx = ctx
+	return clone
+}
+
+// Context, builder'a bağlı context'i döndürür; bağlanmamışsa context.Background döner.
+func (b *Builder) Context() context.Context {
+	if b.ctx == nil {
+		return context.Background()
+	}
+	return b.ctx
+}
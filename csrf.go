@@ -0,0 +1,42 @@
+package builder
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewCSRFToken, oturuma bağlı ve son kullanma zamanı gömülü, HMAC ile imzalanmış bir CSRF token'ı üretir.
+// Üretilen değer Config.CSRFToken olarak verilebilir; doğrulama için sunucuda ek bir depolama gerekmez.
+func NewCSRFToken(secret []byte, sessionID string, ttl time.Duration) string {
+	expires := strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)
+	return expires + "." + base64.RawURLEncoding.EncodeToString(csrfSignature(secret, sessionID, expires))
+}
+
+// ValidateCSRFToken, token'ın verilen oturum için bu secret ile imzalandığını ve süresinin dolmadığını doğrular.
+func ValidateCSRFToken(secret []byte, sessionID, token string) bool {
+	expires, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > unix {
+		return false
+	}
+	given, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(given, csrfSignature(secret, sessionID, expires))
+}
+
+func csrfSignature(secret []byte, sessionID, expires string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(sessionID))
+	mac.Write([]byte{0})
+	mac.Write([]byte(expires))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,106 @@
+package builder
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// Debug, bilinen her alan için bağlanacak değeri, değerin kaynağını (eski girdi ya da model) ve hatasını
+// tablo olarak döndürür. Bilinen alanlar Auto'nun modelden okuduğu alanlar, map modellerin anahtarları,
+// eski girdi ve hata anahtarlarıdır. Yalnızca geliştirme sırasında "değer neden görünmüyor" sorularını
+// çözmek içindir; değerler maskelenmediğinden çıktıya yazılmamalıdır.
+func (b *Builder) Debug() string {
+	var out strings.Builder
+	tw := tabwriter.NewWriter(&out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "FIELD\tSOURCE\tVALUE\tERROR")
+	for _, name := range b.debugFieldNames() {
+		source := "-"
+		if b.hasOldInput(name) {
+			source = "old input"
+		} else if b.model != nil && modelHasField(b.model, name) {
+			source = "model"
+		}
+		value := "-"
+		if source != "-" {
+			value = fmt.Sprintf("%q", strings.Join(b.Values(name), ", "))
+		}
+		errorText := "-"
+		if msgs := b.errorMessages(name); len(msgs) > 0 {
+			errorText = strings.Join(msgs, "; ")
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", name, source, value, errorText)
+	}
+	tw.Flush()
+	return out.String()
+}
+
+// modelHasField, alanın modelde bulunup bulunmadığını söyler. findModelField nil değerleri bulunamamış sayar;
+// burada değeri nil olan map anahtarları da modelden gelmiş kabul edilir.
+func modelHasField(model interface{}, name string) bool {
+	if _, _, ok := findModelField(model, name); ok {
+		return true
+	}
+	path := fieldPath(name)
+	parent := reflect.ValueOf(model)
+	if len(path) > 1 {
+		v, _, ok := findModelField(model, strings.Join(path[:len(path)-1], "."))
+		if !ok {
+			return false
+		}
+		parent = v
+	}
+	for parent.Kind() == reflect.Ptr || parent.Kind() == reflect.Interface {
+		if parent.IsNil() {
+			return false
+		}
+		parent = parent.Elem()
+	}
+	if parent.Kind() != reflect.Map || parent.Type().Key().Kind() != reflect.String {
+		return false
+	}
+	return parent.MapIndex(reflect.ValueOf(path[len(path)-1]).Convert(parent.Type().Key())).IsValid()
+}
+
+func (b *Builder) hasOldInput(name string) bool {
+	cleanName := strings.TrimSuffix(name, "[]")
+	return len(b.oldInput[cleanName]) > 0 || len(b.oldInput[cleanName+"[]"]) > 0
+}
+
+func (b *Builder) debugFieldNames() []string {
+	seen := make(map[string]bool)
+	add := func(name string) {
+		name = strings.TrimSuffix(name, "[]")
+		if name != "" && !strings.HasPrefix(name, originalPrefix) {
+			seen[name] = true
+		}
+	}
+	for _, field := range modelFields(b.model) {
+		add(field.name)
+	}
+	if val := reflect.Indirect(reflect.ValueOf(b.model)); val.Kind() == reflect.Map && val.Type().Key().Kind() == reflect.String {
+		for _, key := range val.MapKeys() {
+			add(key.String())
+		}
+	}
+	for name := range b.oldInput {
+		add(name)
+	}
+	for name := range b.errors {
+		add(name)
+	}
+	for name := range b.fieldErrors {
+		add(name)
+	}
+	for _, skip := range []string{b.csrfField, b.methodField, stepField} {
+		delete(seen, skip)
+	}
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
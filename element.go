@@ -0,0 +1,68 @@
+package builder
+
+import (
+	"html/template"
+	"io"
+	"strings"
+)
+
+// Element, üretilen bir HTML elemanının yapısal halidir. Alan çıktısını string aramak yerine nitelikler
+// üzerinden doğrulamak için kullanılır; HTML metodu aynı elemanı builder'ın ürettiği biçimde yazar.
+type Element struct {
+	Tag        string
+	Attributes map[string]string
+	// Text, elemanın kaçışlanmamış metin içeriğidir; Children'dan önce yazılır.
+	Text     string
+	Children []Element
+}
+
+// voidElements, kapanış etiketi yazılmayan elemanlardır.
+var voidElements = map[string]bool{"input": true, "img": true, "br": true, "hr": true}
+
+// Attr, niteliğin değerini ve tanımlı olup olmadığını döndürür.
+func (e Element) Attr(key string) (string, bool) {
+	v, ok := e.Attributes[key]
+	return v, ok
+}
+
+// Write, elemanı ve çocuklarını w'ye yazar.
+func (e Element) Write(w io.Writer) error {
+	hw := &htmlWriter{w: w}
+	hw.element(e)
+	return hw.err
+}
+
+func (e Element) HTML() template.HTML { return renderHTML(e.Write) }
+
+func (hw *htmlWriter) element(e Element) {
+	hw.tag(e.Tag, e.Attributes)
+	if voidElements[e.Tag] {
+		return
+	}
+	hw.str(template.HTMLEscapeString(e.Text))
+	for _, child := range e.Children {
+		hw.element(child)
+	}
+	hw.str("</" + e.Tag + ">")
+}
+
+// InputNode, Input'un üreteceği input elemanını döndürür. SubmitDisabled ile eklenen gizli kopya elemana dahil değildir.
+func (b *Builder) InputNode(typ, name string, attrs ...map[string]string) Element {
+	node, _ := b.inputNode(typ, name, attrs...)
+	return node
+}
+
+func (b *Builder) TextNode(name string, attrs ...map[string]string) Element {
+	return b.InputNode("text", name, attrs...)
+}
+
+func (b *Builder) inputNode(typ, name string, attrs ...map[string]string) (Element, bool) {
+	attributes := b.inputAttributes(typ, name, attrs...)
+	_, submitDisabled := takeDirective(attributes, submitDisabledDirective)
+	for k := range attributes {
+		if strings.HasPrefix(k, directivePrefix) {
+			delete(attributes, k)
+		}
+	}
+	return Element{Tag: "input", Attributes: attributes}, submitDisabled
+}
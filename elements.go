@@ -1,165 +1,799 @@
 package builder
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"html/template"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
-func (b *Builder) Open() template.HTML {
+const multipartEnctype = "multipart/form-data"
+
+func (b *Builder) Open() template.HTML { return renderHTML(b.WriteOpen) }
+
+func (b *Builder) WriteOpen(w io.Writer) error {
 	actualMethod := "POST"
 	if strings.ToUpper(b.method) == "GET" {
 		actualMethod = "GET"
 	}
-	enctype := ""
-	if b.isMultipart {
-		enctype = ` enctype="multipart/form-data"`
+	enctype := b.enctype
+	if enctype == "" && b.isMultipart { enctype = multipartEnctype }
+	// Tarayıcılar GET formlarında enctype'ı yok sayar ve dosyaları göndermez; multipart formlar bu yüzden POST ile açılır.
+	if actualMethod == "GET" && enctype == multipartEnctype { actualMethod = "POST" }
+	if enctype != "" { enctype = ` enctype="` + template.HTMLEscapeString(enctype) + `"` }
+	action := b.action
+	// Ayrıştırılamayan action değerleri yerine boş action kullanılır; form bulunduğu sayfaya gönderilir.
+	if _, err := url.Parse(action); err != nil {
+		action = ""
+	}
+	hw := &htmlWriter{w: w}
+	class := ""
+	if b.layout == LayoutInline && b.theme.InlineForm != "" { class = ` class="` + template.HTMLEscapeString(b.theme.InlineForm) + `"` }
+	id := ""
+	if b.formID != "" { id = ` id="` + template.HTMLEscapeString(b.formID) + `"` }
+	hw.str(fmt.Sprintf(`<form method="%s" action="%s"%s%s%s>`, actualMethod, template.HTMLEscapeString(action), class, id, enctype))
+	hw.str("\n")
+	for _, field := range b.autoHiddenFields(actualMethod) {
+		hw.str(fmt.Sprintf(`<input type="hidden" name="%s" value="%s">`, template.HTMLEscapeString(field[0]), template.HTMLEscapeString(field[1])))
+		hw.str("\n")
 	}
-	formTag := fmt.Sprintf(`<form method="%s" action="%s"%s>`, actualMethod, b.action, enctype)
-	csrfField := ""
-	if b.csrfToken != "" {
-		csrfField = fmt.Sprintf(`<input type="hidden" name="%s" value="%s">`, b.csrfField, b.csrfToken)
+	return hw.err
+}
+
+// autoHiddenFields, Open'ın form etiketinden hemen sonra yazdığı gizli alanları sırasıyla döndürür:
+// CSRF token, method spoofing alanı, GET formlarında action'ın sorgu parametreleri ve AddHidden ile eklenenler.
+func (b *Builder) autoHiddenFields(actualMethod string) [][2]string {
+	var fields [][2]string
+	if b.csrfToken != "" { fields = append(fields, [2]string{b.csrfField, b.csrfToken}) }
+	if m := strings.ToUpper(b.method); m == "PUT" || m == "PATCH" || m == "DELETE" {
+		fields = append(fields, [2]string{b.methodField, m})
 	}
-	methodField := ""
-	if m := strings.ToUpper(b.method); m != "GET" && m != "POST" {
-		methodField = fmt.Sprintf(`<input type="hidden" name="_method" value="%s">`, m)
+	if actualMethod == "GET" {
+		// Tarayıcılar GET formlarında action'daki sorgu dizesini atar; bu yüzden parametreler gizli alan olarak taşınır.
+		fields = append(fields, b.queryFields()...)
 	}
-	return template.HTML(formTag + "\n" + csrfField + "\n" + methodField)
+	return append(fields, b.hiddenFields...)
 }
 
+// AddHidden, Open'ın CSRF ve method alanlarının ardından yazacağı bir gizli alan kaydeder. Builder'ı değiştirdiği
+// için render'dan önce, tercihen istek başına Clone edilmiş bir kopya üzerinde çağrılmalıdır.
+func (b *Builder) AddHidden(name, value string) { b.hiddenFields = append(b.hiddenFields, [2]string{name, value}) }
+
 func (b *Builder) Close() template.HTML { return `</form>` }
 
+func (b *Builder) WriteClose(w io.Writer) error {
+	_, err := io.WriteString(w, `</form>`)
+	return err
+}
+
+// Value, alana bağlanacak değeri input metodlarıyla aynı sırayla (OldInput, Model) çözer; değer yoksa boş döner.
+// Çok değerli alanlarda ilk değer döner, tümü için Values kullanılır.
+func (b *Builder) Value(name string) string {
+	if values := b.Values(name); len(values) > 0 { return values[0] }
+	return ""
+}
+
+// Values, alana bağlı tüm değerleri döndürür; çoklu seçim ve onay kutusu grupları içindir.
+func (b *Builder) Values(name string) []string {
+	value := b.resolveValue(name)
+	if t, ok := value.(time.Time); ok {
+		if t.IsZero() { return nil }
+		return []string{t.Format(time.RFC3339)}
+	}
+	return b.resolveValueAsSlice(name)
+}
+
+// ValueFor, Value gibidir ancak değeri builder'ın modeli yerine verilen modelden çözer; OldInput yine önceliklidir.
+func (b *Builder) ValueFor(model interface{}, name string) string { return b.WithModel(model).Value(name) }
+
+// InputFor, alanı formun ana modeli yerine verilen modele bağlar; eski girdi ve hata durumu builder'dan gelir.
+// Aynı formda ilişkili bir kaydın alanlarını ikinci bir builder kurmadan göstermek içindir.
+func (b *Builder) InputFor(model interface{}, typ, name string, attrs ...map[string]string) template.HTML {
+	return b.WithModel(model).Input(typ, name, attrs...)
+}
+
+func (b *Builder) TextFor(model interface{}, name string, attrs ...map[string]string) template.HTML {
+	return b.InputFor(model, "text", name, attrs...)
+}
+
+// HasError, alan için gösterilecek bir hata olup olmadığını bildirir.
+func (b *Builder) HasError(name string) bool { return b.hasError(name) }
+
+// IsInvalid, HasError ile aynıdır; şablonlarda okunabilirlik içindir.
+func (b *Builder) IsInvalid(name string) bool { return b.hasError(name) }
+
+// Error, alanın ilk hata mesajını döndürür; hata yoksa boş döner.
+func (b *Builder) Error(name string) string {
+	if msgs := b.errorMessages(name); len(msgs) > 0 { return msgs[0] }
+	return ""
+}
+
 func (b *Builder) Label(name, text string, attrs ...map[string]string) template.HTML {
 	attributes := mergeAttributes(attrs...)
-	attributes["for"] = name
+	attributes["for"] = b.ID(name)
+	if _, ok := attributes["class"]; !ok { attributes["class"] = b.labelClass() }
+	if attributes["class"] == "" { delete(attributes, "class") }
+	required := b.hasValidationRule(name, "required")
+	if b.templates["label"] != nil {
+		return renderHTML(func(w io.Writer) error {
+			_, err := b.writeWidget(w, WidgetContext{Widget: "label", Name: name, ID: attributes["for"], Text: b.translate(text), Required: required, Attrs: attributes})
+			return err
+		})
+	}
+	text = template.HTMLEscapeString(b.translate(text))
+	if required {
+		text += fmt.Sprintf(` <span class="%s">*</span>`, b.theme.RequiredMark)
+	}
 	return template.HTML(fmt.Sprintf(`<label %s>%s</label>`, buildAttributes(attributes), text))
 }
 
 func (b *Builder) Input(typ, name string, attrs ...map[string]string) template.HTML {
-	attributes := mergeAttributes(attrs...)
-	finalClass := "form-control"
+	return renderHTML(func(w io.Writer) error { return b.WriteInput(w, typ, name, attrs...) })
+}
+
+func (b *Builder) WriteInput(w io.Writer, typ, name string, attrs ...map[string]string) error {
+	node, submitDisabled := b.inputNode(typ, name, attrs...)
+	attributes := node.Attributes
+	hw := &htmlWriter{w: w}
+	ctx := WidgetContext{Widget: attributes["type"], Name: name, ID: attributes["id"], Value: attributes["value"], Attrs: attributes}
+	if handled, err := b.writeWidget(w, ctx); !handled {
+		hw.element(node)
+	} else if err != nil {
+		return err
+	}
+	if _, disabled := attributes["disabled"]; disabled && submitDisabled && submitsValue(attributes) {
+		hw.tag("input", b.applyFormAttribute(map[string]string{"type": "hidden", "name": attributes["name"], "value": attributes["value"]}))
+	}
+	if b.trackChanges && !untrackedTypes[attributes["type"]] {
+		var rendered []string
+		if v, ok := attributes["value"]; ok { rendered = []string{v} }
+		b.writeOriginal(hw, attributes["type"], name, rendered)
+	}
+	return hw.err
+}
+
+// submitsValue, devre dışı bırakılmasaydı tarayıcının alanın değerini gönderip göndermeyeceğini söyler:
+// işaretsiz onay kutuları ve radio'lar gönderilmez, parola değerleri hiç yazılmadığından kopyalanmaz.
+func submitsValue(attributes map[string]string) bool {
+	switch attributes["type"] {
+	case "checkbox", "radio":
+		_, checked := attributes["checked"]
+		return checked
+	case "password":
+		return false
+	}
+	return true
+}
+
+func (b *Builder) inputAttributes(typ, name string, attrs ...map[string]string) map[string]string {
+	attributes := b.applyFormAttribute(mergeAttributes(attrs...))
+	precision, hasPrecision := takeDirective(attributes, precisionDirective)
+	if typ == "text" && b.html5Validation && b.hasValidationRule(name, "email") {
+		typ = "email"
+	}
+	b.applyHTML5Validation(attributes, typ, name)
+	baseClass := b.theme.Input
 	if typ == "range" {
-		finalClass = "form-range"
+		baseClass = b.theme.Range
 	} else if typ == "checkbox" || typ == "radio" {
-		finalClass = "form-check-input"
+		baseClass = b.theme.Check
 	}
-
-	if b.hasError(name) {
-		finalClass += " is-invalid"
+	state := b.stateClass(name)
+	if _, disabled := attributes["disabled"]; disabled {
+		state = ""
 	}
-	if userClass, ok := attributes["class"]; ok {
-		attributes["class"] = userClass
-		if !strings.Contains(userClass, "form-control") && !strings.Contains(userClass, "form-range") && !strings.Contains(userClass, "form-check-input") {
-			attributes["class"] += " " + finalClass
-		}
-	} else {
-		attributes["class"] = finalClass
+	// Gizli alanlar görünmediği için stil sınıfı almaz.
+	if typ != "hidden" {
+		applyClass(attributes, baseClass, state)
+		b.applyAria(attributes, name)
 	}
 	attributes["name"] = name
-	attributes["id"] = nameOrID(attributes, name)
+	attributes["id"] = nameOrID(attributes, b.ID(name))
 	attributes["type"] = typ
+	if _, ok := attributes["placeholder"]; !ok && b.autoPlaceholder && placeholderTypes[typ] {
+		attributes["placeholder"] = humanize(name)
+	}
+	if placeholder, ok := attributes["placeholder"]; ok {
+		attributes["placeholder"] = b.translate(placeholder)
+	}
 	if _, ok := attributes["value"]; !ok {
 		value := b.resolveValue(name)
 		if value != nil && typ != "password" && typ != "file" {
-			attributes["value"] = fmt.Sprintf("%v", value)
+			attributes["value"] = formatValue(typ, value)
+			if f, ok := floatValue(value); ok && hasPrecision {
+				if n, err := strconv.Atoi(precision); err == nil { attributes["value"] = formatFloat(f, n) }
+			}
+			if attributes["value"] == "" && b.omitEmptyValue { delete(attributes, "value") }
 		}
 	}
 	if typ == "password" {
 		delete(attributes, "value")
 	}
-	return template.HTML(fmt.Sprintf(`<input %s>`, buildAttributes(attributes)))
+	return attributes
 }
 
 func (b *Builder) Text(name string, attrs ...map[string]string) template.HTML { return b.Input("text", name, attrs...) }
+
+// InputValue, değeri modelden ve eski girdiden çözmeden tam olarak verilen value ile alan üretir; hata durumu yine uygulanır.
+func (b *Builder) InputValue(typ, name, value string, attrs ...map[string]string) template.HTML {
+	return b.Input(typ, name, mergeAttributes(attrs...), Attr{"value": value})
+}
+
+func (b *Builder) TextValue(name, value string, attrs ...map[string]string) template.HTML { return b.InputValue("text", name, value, attrs...) }
+func (b *Builder) HiddenValue(name, value string, attrs ...map[string]string) template.HTML { return b.InputValue("hidden", name, value, attrs...) }
+
+func (b *Builder) WriteText(w io.Writer, name string, attrs ...map[string]string) error {
+	return b.WriteInput(w, "text", name, attrs...)
+}
+
 func (b *Builder) Email(name string, attrs ...map[string]string) template.HTML { return b.Input("email", name, attrs...) }
 func (b *Builder) Password(name string, attrs ...map[string]string) template.HTML { return b.Input("password", name, attrs...) }
 func (b *Builder) Hidden(name string, attrs ...map[string]string) template.HTML { return b.Input("hidden", name, attrs...) }
+// File, dosya alanı üretir. Render sırasında builder değiştirilmez; upload formları için Config.Multipart
+// verilmeli ya da modeldeki alan `type:"file"` ile işaretlenmelidir.
 func (b *Builder) File(name string, attrs ...map[string]string) template.HTML { return b.Input("file", name, attrs...) }
+
+// PasswordConfirmation, kayıt formları için şifre ve şifre tekrarı alanlarını autocomplete="new-password" ile üretir.
+// Değerler hiçbir zaman geri yazılmaz; verilen nitelikler iki alana da uygulanır.
+func (b *Builder) PasswordConfirmation(name, confirmName string, attrs ...map[string]string) (template.HTML, template.HTML) {
+	attributes := mergeAttributes(Attr{"autocomplete": "new-password"}, mergeAttributes(attrs...))
+	return b.Password(name, attributes), b.Password(confirmName, attributes)
+}
+
+// PasswordsMatch, gönderilen şifre ile tekrarının aynı olup olmadığını sabit zamanlı karşılaştırmayla denetler.
+func PasswordsMatch(values url.Values, name, confirm string) bool {
+	return subtle.ConstantTimeCompare([]byte(values.Get(name)), []byte(values.Get(confirm))) == 1
+}
+
+func (b *Builder) FileMultiple(name string, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	attributes["multiple"] = ""
+	return b.File(name, attributes)
+}
+
+func (b *Builder) URL(name string, attrs ...map[string]string) template.HTML { return b.Input("url", name, attrs...) }
+func (b *Builder) Tel(name string, attrs ...map[string]string) template.HTML { return b.Input("tel", name, attrs...) }
+func (b *Builder) Search(name string, attrs ...map[string]string) template.HTML { return b.Input("search", name, attrs...) }
+// Datalist, tarayıcının yerel otomatik tamamlamasını kullanan bir metin alanı ve ona bağlı <datalist> üretir.
+func (b *Builder) Datalist(name string, suggestions []string, attrs ...map[string]string) template.HTML {
+	listID := b.ID(name) + "-list"
+	attributes := mergeAttributes(attrs...)
+	attributes["list"] = listID
+	var html strings.Builder
+	html.WriteString(string(b.Input("text", name, attributes)))
+	html.WriteString(fmt.Sprintf(`<datalist id="%s">`, template.HTMLEscapeString(listID)))
+	for _, suggestion := range suggestions {
+		html.WriteString(fmt.Sprintf(`<option value="%s">`, template.HTMLEscapeString(suggestion)))
+	}
+	html.WriteString(`</datalist>`)
+	return template.HTML(html.String())
+}
+
 func (b *Builder) Number(name string, attrs ...map[string]string) template.HTML { return b.Input("number", name, attrs...) }
 func (b *Builder) Date(name string, attrs ...map[string]string) template.HTML { return b.Input("date", name, attrs...) }
 func (b *Builder) Time(name string, attrs ...map[string]string) template.HTML { return b.Input("time", name, attrs...) }
+func (b *Builder) Month(name string, attrs ...map[string]string) template.HTML { return b.Input("month", name, attrs...) }
+func (b *Builder) Week(name string, attrs ...map[string]string) template.HTML { return b.Input("week", name, attrs...) }
 func (b *Builder) DatetimeLocal(name string, attrs ...map[string]string) template.HTML { return b.Input("datetime-local", name, attrs...) }
-func (b *Builder) Range(name string, attrs ...map[string]string) template.HTML { return b.Input("range", name, attrs...) }
 
-func (b *Builder) Textarea(name string, attrs ...map[string]string) template.HTML {
+// DateText, time.Time bir alanı verilen Go layout'u ile biçimleyip metin alanına bağlar; JS tarih seçicilerle
+// HTML5 date input yerine kullanılır. Eski girdi olduğu gibi gösterilir.
+func (b *Builder) DateText(name, layout string, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	if _, ok := attributes["value"]; !ok {
+		switch v := b.resolveValue(name).(type) {
+		case nil:
+		case time.Time:
+			attributes["value"] = ""
+			if !v.IsZero() { attributes["value"] = v.Format(layout) }
+		default:
+			attributes["value"] = formatValue("", v)
+		}
+	}
+	return b.Input("text", name, attributes)
+}
+
+// Range, min ve max sınırlarıyla bir kaydırıcı üretir ve bağlanan değeri bu aralığa sıkıştırır; step 0 ise yazılmaz.
+func (b *Builder) Range(name string, min, max, step int, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	attributes["min"] = strconv.Itoa(min)
+	attributes["max"] = strconv.Itoa(max)
+	if step != 0 { attributes["step"] = strconv.Itoa(step) } else { delete(attributes, "step") }
+	return b.RangeAttrs(name, attributes)
+}
+
+// RangeAttrs, sınırları niteliklerden ya da validate min/max kurallarından alan serbest biçimli Range'dir.
+func (b *Builder) RangeAttrs(name string, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	if step, ok := attributes["step"]; ok && (step == "" || step == "0") {
+		delete(attributes, "step")
+	}
+	if b.html5Validation {
+		for k, v := range html5Attributes("range", b.validationRules(name)) {
+			if _, ok := attributes[k]; !ok && (k == "min" || k == "max") { attributes[k] = v }
+		}
+	}
+	value, ok := attributes["value"]
+	if !ok {
+		v := b.resolveValue(name)
+		if v == nil { return b.Input("range", name, attributes) }
+		value = formatValue("range", v)
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		if min, err := strconv.ParseFloat(attributes["min"], 64); err == nil && f < min { f = min }
+		if max, err := strconv.ParseFloat(attributes["max"], 64); err == nil && f > max { f = max }
+		value = strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	attributes["value"] = value
+	return b.Input("range", name, attributes)
+}
+
+func (b *Builder) Color(name string, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	value, ok := attributes["value"]
+	if !ok {
+		if v := b.resolveValue(name); v != nil { value = formatValue("color", v) }
+	}
+	attributes["value"] = normalizeColor(value)
+	return b.Input("color", name, attributes)
+}
+
+// Tags, []string bir alanın değerlerini virgülle birleştirip bootstrap-tagsinput gibi kütüphanelerin
+// okuduğu data-role="tagsinput" ile bir metin alanı üretir. Gönderilen değer ParseTags ile geri ayrıştırılır.
+func (b *Builder) Tags(name string, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(Attr{"data-role": "tagsinput"}, mergeAttributes(attrs...))
+	if _, ok := attributes["value"]; !ok { attributes["value"] = strings.Join(b.Values(name), ",") }
+	return b.Input("text", name, attributes)
+}
+
+// ParseTags, virgülle ayrılmış etiketleri boşlukları kırparak ayırır; boş ve tekrar eden etiketler atlanır.
+func ParseTags(v string) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, tag := range strings.Split(v, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] { continue }
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// Textarea, verilen satır ve sütun sayısıyla bir metin kutusu üretir; sıfır olan boyut yazılmaz.
+func (b *Builder) Textarea(name string, rows, cols int, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	if rows > 0 { attributes["rows"] = strconv.Itoa(rows) }
+	if cols > 0 { attributes["cols"] = strconv.Itoa(cols) }
+	return renderHTML(func(w io.Writer) error { return b.WriteTextarea(w, name, attributes) })
+}
+
+func (b *Builder) WriteTextarea(w io.Writer, name string, attrs ...map[string]string) error {
 	attributes := mergeAttributes(attrs...)
 	value := b.resolveValue(name)
 	delete(attributes, "value")
-	finalClass := "form-control"
-	if b.hasError(name) { finalClass += " is-invalid" }
-	if userClass, ok := attributes["class"]; ok {
-		attributes["class"] = userClass + " " + finalClass
-	} else {
-		attributes["class"] = finalClass
+	_, submitDisabled := takeDirective(attributes, submitDisabledDirective)
+	for _, dim := range []string{"rows", "cols"} {
+		if v, ok := attributes[dim]; ok && (v == "" || v == "0") {
+			delete(attributes, dim)
+		}
 	}
+	applyClass(attributes, b.theme.Input, b.stateClass(name))
+	b.applyHTML5Validation(attributes, "textarea", name)
+	b.applyAria(attributes, name)
 	attributes["name"] = name
-	attributes["id"] = nameOrID(attributes, name)
+	attributes["id"] = nameOrID(attributes, b.ID(name))
+	if _, ok := attributes["placeholder"]; !ok && b.autoPlaceholder {
+		attributes["placeholder"] = humanize(name)
+	}
+	if placeholder, ok := attributes["placeholder"]; ok {
+		attributes["placeholder"] = b.translate(placeholder)
+	}
 	var valStr string
 	if value != nil {
 		valStr = fmt.Sprintf("%v", value)
 	}
-	escapedValue := template.HTMLEscapeString(valStr)
-	return template.HTML(fmt.Sprintf(`<textarea %s>%s</textarea>`, buildAttributes(attributes), escapedValue))
+	ctx := WidgetContext{Widget: "textarea", Name: name, ID: attributes["id"], Value: valStr, Attrs: attributes}
+	hw := &htmlWriter{w: w}
+	if handled, err := b.writeWidget(w, ctx); !handled {
+		hw.tag("textarea", b.applyFormAttribute(attributes))
+		hw.str(template.HTMLEscapeString(valStr))
+		hw.str(`</textarea>`)
+	} else if err != nil {
+		return err
+	}
+	if _, disabled := attributes["disabled"]; disabled && submitDisabled {
+		hw.tag("input", b.applyFormAttribute(map[string]string{"type": "hidden", "name": name, "value": valStr}))
+	}
+	if b.trackChanges { b.writeOriginal(hw, "", name, []string{valStr}) }
+	return hw.err
 }
 
 func (b *Builder) Select(name string, options interface{}, attrs ...map[string]string) template.HTML {
+	return renderHTML(func(w io.Writer) error { return b.WriteSelect(w, name, options, attrs...) })
+}
+
+func (b *Builder) WriteSelect(w io.Writer, name string, options interface{}, attrs ...map[string]string) error {
 	attributes := mergeAttributes(attrs...)
 	selectedValues := b.resolveValueAsSlice(name)
-	finalClass := "form-select"
-	if b.hasError(name) { finalClass += " is-invalid" }
-	if userClass, ok := attributes["class"]; ok {
-		attributes["class"] = userClass + " " + finalClass
-	} else {
-		attributes["class"] = finalClass
-	}
+	applyClass(attributes, b.theme.Select, b.stateClass(name))
+	b.applyHTML5Validation(attributes, "select", name)
+	b.applyAria(attributes, name)
 	attributes["name"] = name
-	attributes["id"] = nameOrID(attributes, name)
+	attributes["id"] = nameOrID(attributes, b.ID(name))
 	if _, ok := attributes["multiple"]; ok {
 		attributes["name"] += "[]"
 	}
-	optionsHtml := buildOptions(options, selectedValues)
-	return template.HTML(fmt.Sprintf(`<select %s>%s</select>`, buildAttributes(attributes), optionsHtml))
+	_, submitDisabled := takeDirective(attributes, submitDisabledDirective)
+	placeholder, hasPlaceholder := attributes["placeholder"]
+	delete(attributes, "placeholder")
+	// Çoklu seçimde seçilemeyen boş seçenek listede her zaman seçili görüneceği için placeholder yazılmaz.
+	if _, multiple := attributes["multiple"]; multiple { hasPlaceholder = false }
+	disabled := disabledOptionValues(options)
+	if values, ok := takeDirective(attributes, disabledOptionsDirective); ok {
+		for _, v := range strings.Split(values, "\n") { disabled[v] = true }
+	}
+	if len(disabled) > 0 {
+		enabled := selectedValues[:0:0]
+		for _, v := range selectedValues {
+			if !disabled[v] { enabled = append(enabled, v) }
+		}
+		selectedValues = enabled
+	}
+	ctx := WidgetContext{Widget: "select", Name: name, ID: attributes["id"], Values: selectedValues, Options: options, Attrs: attributes}
+	if len(selectedValues) > 0 { ctx.Value = selectedValues[0] }
+	hw := &htmlWriter{w: w}
+	if handled, err := b.writeWidget(w, ctx); !handled {
+		hw.tag("select", b.applyFormAttribute(attributes))
+		if hasPlaceholder {
+			selected := " selected"
+			for _, v := range selectedValues {
+				if v != "" { selected = "" }
+			}
+			hw.str(fmt.Sprintf(`<option value="" disabled%s>%s</option>`, selected, template.HTMLEscapeString(b.translate(placeholder))))
+		}
+		writeOptions(hw, options, selectedValues, disabled)
+		hw.str(`</select>`)
+	} else if err != nil {
+		return err
+	}
+	if _, disabled := attributes["disabled"]; disabled && submitDisabled {
+		for _, v := range selectedValues {
+			hw.tag("input", b.applyFormAttribute(map[string]string{"type": "hidden", "name": attributes["name"], "value": v}))
+		}
+	}
+	if b.trackChanges { b.writeOriginal(hw, "", attributes["name"], selectedValues) }
+	return hw.err
+}
+
+func (b *Builder) MultiSelect(name string, options []Option, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	attributes["multiple"] = ""
+	return b.Select(name, options, attributes)
 }
 
+// ListBox, size satır yüksekliğinde çoklu seçimli bir liste kutusu üretir; ad ve değer bağlama MultiSelect ile aynıdır.
+func (b *Builder) ListBox(name string, options []Option, size int, attrs ...map[string]string) template.HTML {
+	return b.MultiSelect(name, options, Size(size), mergeAttributes(attrs...))
+}
+
+func (b *Builder) SelectGroups(name string, groups []OptGroup, attrs ...map[string]string) template.HTML {
+	return b.Select(name, groups, attrs...)
+}
+
+// Checkbox, tek bir onay kutusu üretir. value boşsa "1" kullanılır. bool alanlarda kutu alan true iken,
+// value "0"/"false" ise alan false iken işaretlenir; "1", "on", "true" gibi metin değerleri birbirine eşdeğer sayılır.
 func (b *Builder) Checkbox(name, value string, attrs ...map[string]string) template.HTML {
+	if value == "" { value = "1" }
 	attributes := mergeAttributes(attrs...)
-	selectedValue := b.resolveValue(name)
+	selectedValue := b.checkedValue(name)
 	attributes["type"] = "checkbox"
 	attributes["name"] = name
-	attributes["id"] = nameOrID(attributes, fmt.Sprintf("%s_%s", name, value))
+	attributes["id"] = nameOrID(attributes, b.ID(name+"_"+value))
 	attributes["value"] = value
 	if isChecked(selectedValue, value) {
 		attributes["checked"] = "checked"
 	}
-	return b.Input("checkbox", name, attributes)
+	hidden := fmt.Sprintf(`<input %s>`, buildAttributes(b.applyFormAttribute(map[string]string{"type": "hidden", "name": name, "value": ""})))
+	return template.HTML(hidden) + b.Input("checkbox", name, attributes)
+}
+
+func (b *Builder) CheckboxGroup(name string, options []Option, attrs ...map[string]string) template.HTML {
+	selectedValue := b.checkedValue(name)
+	groupName := strings.TrimSuffix(name, "[]") + "[]"
+	var html strings.Builder
+	html.WriteString(fmt.Sprintf(`<input %s>`, buildAttributes(b.applyFormAttribute(map[string]string{"type": "hidden", "name": groupName, "value": ""}))))
+	for _, opt := range options {
+		attributes := mergeAttributes(attrs...)
+		id := b.ID(strings.TrimSuffix(name, "[]") + "_" + opt.Value)
+		attributes["id"] = id
+		attributes["value"] = opt.Value
+		if isChecked(selectedValue, opt.Value) {
+			attributes["checked"] = "checked"
+		}
+		html.WriteString(fmt.Sprintf(`<div class="%s">`, b.theme.CheckWrapper))
+		html.WriteString(string(b.Input("checkbox", groupName, attributes)))
+		html.WriteString(fmt.Sprintf(`<label class="%s" for="%s">%s</label></div>`, b.theme.CheckLabel, template.HTMLEscapeString(id), template.HTMLEscapeString(b.translate(opt.Text))))
+	}
+	return template.HTML(html.String())
+}
+
+func (b *Builder) Switch(name, value string, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	attributes["role"] = "switch"
+	return template.HTML(fmt.Sprintf(`<div class="%s">`, b.theme.Switch)) + b.Checkbox(name, value, attributes) + `</div>`
 }
 
 func (b *Builder) Radio(name, value string, attrs ...map[string]string) template.HTML {
 	attributes := mergeAttributes(attrs...)
 	selectedValue := b.resolveValue(name)
-	if fmt.Sprintf("%v", selectedValue) == value {
+	attributes["id"] = nameOrID(attributes, b.ID(name+"_"+value))
+	attributes["value"] = value
+	if selectedValue != nil && fmt.Sprintf("%v", selectedValue) == value {
 		attributes["checked"] = "checked"
 	}
 	return b.Input("radio", name, attributes)
 }
 
+func (b *Builder) RadioGroup(name string, options []Option, attrs ...map[string]string) template.HTML {
+	base := mergeAttributes(attrs...)
+	wrapperClass := b.theme.CheckWrapper
+	if _, inline := takeDirective(base, inlineDirective); inline {
+		wrapperClass += " " + b.theme.CheckInline
+	}
+	// Verilen id her radio'ya kopyalanırsa id'ler çakışır; bu yüzden yalnızca önek olarak kullanılır.
+	prefix, hasPrefix := base["id"]
+	delete(base, "id")
+	var html strings.Builder
+	for _, opt := range options {
+		attributes := mergeAttributes(base)
+		id := b.ID(name + "_" + opt.Value)
+		if hasPrefix && prefix != "" { id = prefix + "_" + idReplacer.Replace(opt.Value) }
+		attributes["id"] = id
+		html.WriteString(fmt.Sprintf(`<div class="%s">`, wrapperClass))
+		html.WriteString(string(b.Radio(name, opt.Value, attributes)))
+		html.WriteString(fmt.Sprintf(`<label class="%s" for="%s">%s</label></div>`, b.theme.CheckLabel, template.HTMLEscapeString(id), template.HTMLEscapeString(b.translate(opt.Text))))
+	}
+	return template.HTML(html.String())
+}
+
 func (b *Builder) Submit(text string, attrs ...map[string]string) template.HTML {
 	attributes := mergeAttributes(attrs...)
 	attributes["type"] = "submit"
-	if _, ok := attributes["class"]; !ok { attributes["class"] = "btn btn-primary" }
-	return template.HTML(fmt.Sprintf(`<button %s>%s</button>`, buildAttributes(attributes), text))
+	if _, ok := attributes["class"]; !ok { attributes["class"] = b.theme.SubmitButton }
+	b.applyFormAttribute(attributes)
+	return template.HTML(fmt.Sprintf(`<button %s>%s</button>`, buildAttributes(attributes), template.HTMLEscapeString(b.translate(text))))
+}
+
+// Image, tıklanan noktanın name.x ve name.y koordinatlarıyla formu gönderen bir resim butonu üretir.
+func (b *Builder) Image(name, src, alt string, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	attributes["type"] = "image"
+	attributes["name"] = name
+	attributes["src"] = src
+	attributes["alt"] = b.translate(alt)
+	b.applyFormAttribute(attributes)
+	return template.HTML(fmt.Sprintf(`<input %s>`, buildAttributes(attributes)))
+}
+
+// Button, verilen türde bir <button> üretir; btnType boşsa "button" kullanılır.
+func (b *Builder) Button(label, btnType string, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	if btnType == "" { btnType = "button" }
+	attributes["type"] = btnType
+	if _, ok := attributes["class"]; !ok { attributes["class"] = b.theme.Button }
+	b.applyFormAttribute(attributes)
+	return template.HTML(fmt.Sprintf(`<button %s>%s</button>`, buildAttributes(attributes), template.HTMLEscapeString(b.translate(label))))
+}
+
+func (b *Builder) Reset(label string, attrs ...map[string]string) template.HTML {
+	return b.Button(label, "reset", attrs...)
+}
+
+// Group, etiketi, alanı ve hata mesajını bir sarmalayıcı div içinde üretir. Sarmalayıcının sınıfı Config.GroupClass'tan
+// ya da temadan gelir; verilen niteliklerdeki class bunu bu çağrı için değiştirir. Sınıf boşsa ve başka nitelik yoksa div yazılmaz.
+func (b *Builder) Group(name, label string, input template.HTML, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	if _, ok := attributes["class"]; !ok { attributes["class"] = b.groupWrapperClass() }
+	if attributes["class"] == "" { delete(attributes, "class") }
+	var html strings.Builder
+	if len(attributes) > 0 { html.WriteString("<div " + buildAttributes(attributes) + ">") }
+	html.WriteString(string(b.Label(name, label)))
+	// Yatay düzende alan ve hata mesajı etiketin yanındaki sütuna yerleşir.
+	if b.layout == LayoutHorizontal { html.WriteString(fmt.Sprintf(`<div class="%s">`, b.theme.HorizontalInput)) }
+	html.WriteString(string(input))
+	if msgs := b.errorMessages(name); len(msgs) > 0 {
+		html.WriteString(b.feedback(b.theme.ErrorFeedbackClass, b.errorID(name), msgs[0]))
+	}
+	if b.layout == LayoutHorizontal { html.WriteString(`</div>`) }
+	if len(attributes) > 0 { html.WriteString(`</div>`) }
+	return template.HTML(html.String())
+}
+
+func (b *Builder) groupWrapperClass() string {
+	if b.groupClass != nil { return *b.groupClass }
+	switch b.layout {
+	case LayoutHorizontal: return b.theme.HorizontalGroup
+	case LayoutInline: return b.theme.InlineGroup
+	}
+	return b.theme.Group
+}
+
+func (b *Builder) labelClass() string {
+	switch b.layout {
+	case LayoutHorizontal: return b.theme.HorizontalLabel
+	case LayoutInline: return b.theme.InlineLabel
+	}
+	return b.theme.Label
+}
+
+// Fieldset, içeriği başlığı legend olan bir <fieldset> içine alır; legend boşsa <legend> yazılmaz.
+// Disabled() verilirse tarayıcı içerdeki tüm alanları devre dışı bırakır ve gönderime katmaz.
+func (b *Builder) Fieldset(legend string, content template.HTML, attrs ...map[string]string) template.HTML {
+	return renderHTML(func(w io.Writer) error {
+		hw := &htmlWriter{w: w}
+		hw.tag("fieldset", mergeAttributes(attrs...))
+		if legend != "" {
+			hw.str("<legend>")
+			hw.str(template.HTMLEscapeString(b.translate(legend)))
+			hw.str("</legend>")
+		}
+		hw.str(string(content))
+		hw.str("</fieldset>")
+		return hw.err
+	})
+}
+
+// Floating, Bootstrap'in floating label düzenini üretir: input etiketten önce gelir ve placeholder zorunludur.
+func (b *Builder) Floating(name, label string, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	if _, ok := attributes["placeholder"]; !ok { attributes["placeholder"] = label }
+	typ := "text"
+	if t := attributes["type"]; t != "" { typ = t }
+	var html strings.Builder
+	html.WriteString(fmt.Sprintf(`<div class="%s">`, b.theme.Floating))
+	html.WriteString(string(b.Input(typ, name, attributes)))
+	html.WriteString(string(b.Label(name, label, Attr{"class": b.theme.FloatingLabel})))
+	html.WriteString(string(b.FieldError(name)))
+	html.WriteString(`</div>`)
+	return template.HTML(html.String())
+}
+
+// Currency, para birimi simgesi başa eklenmiş, iki ondalık basamakla biçimlenen bir tutar alanı üretir.
+func (b *Builder) Currency(name, symbol string, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(Attr{"step": "0.01", "inputmode": "decimal"}, Precision(2), mergeAttributes(attrs...))
+	return b.InputGroup(name, template.HTML(template.HTMLEscapeString(symbol)), "", b.Number(name, attributes))
+}
+
+// Meter, salt okunur bir <meter> üretir. value verilmezse değer modelden ya da eski girdiden okunur; değer [min, max] aralığına sıkıştırılır.
+func (b *Builder) Meter(name string, min, max float64, value ...float64) template.HTML {
+	return b.gauge("meter", name, min, max, value)
+}
+
+// Progress, 0 ile max arasında bir <progress> üretir; değer Meter ile aynı şekilde çözülür.
+func (b *Builder) Progress(name string, max float64, value ...float64) template.HTML {
+	return b.gauge("progress", name, 0, max, value)
+}
+
+func (b *Builder) gauge(tag, name string, min, max float64, value []float64) template.HTML {
+	var v float64
+	if len(value) > 0 {
+		v = value[0]
+	} else if f, err := strconv.ParseFloat(b.Value(name), 64); err == nil {
+		v = f
+	}
+	if v < min { v = min }
+	if v > max { v = max }
+	attributes := map[string]string{"id": b.ID(name), "max": formatFloat(max, -1), "value": formatFloat(v, -1)}
+	if tag == "meter" { attributes["min"] = formatFloat(min, -1) }
+	return renderHTML(func(w io.Writer) error {
+		hw := &htmlWriter{w: w}
+		hw.tag(tag, attributes)
+		hw.str(attributes["value"])
+		hw.str("</" + tag + ">")
+		return hw.err
+	})
+}
+
+func (b *Builder) InputGroup(name string, prepend, append, input template.HTML) template.HTML {
+	var html strings.Builder
+	html.WriteString(fmt.Sprintf(`<div class="%s">`, b.theme.InputGroup))
+	if prepend != "" {
+		html.WriteString(fmt.Sprintf(`<span class="%s">%s</span>`, b.theme.InputGroupText, prepend))
+	}
+	html.WriteString(string(input))
+	if append != "" {
+		html.WriteString(fmt.Sprintf(`<span class="%s">%s</span>`, b.theme.InputGroupText, append))
+	}
+	html.WriteString(`</div>`)
+	// Bootstrap'te hata mesajı input-group'un kardeşi olduğunda ancak d-block ile görünür.
+	html.WriteString(string(b.FieldError(name)))
+	return template.HTML(html.String())
 }
 
-func (b *Builder) Button(text string, attrs ...map[string]string) template.HTML {
+func (b *Builder) Script(body template.JS, attrs ...map[string]string) template.HTML {
 	attributes := mergeAttributes(attrs...)
-	if _, ok := attributes["type"]; !ok { attributes["type"] = "button" }
-	if _, ok := attributes["class"]; !ok { attributes["class"] = "btn btn-secondary" }
-	return template.HTML(fmt.Sprintf(`<button %s>%s</button>`, buildAttributes(attributes), text))
+	if nonce := b.Nonce(); nonce != "" { attributes["nonce"] = nonce }
+	return renderHTML(func(w io.Writer) error {
+		hw := &htmlWriter{w: w}
+		hw.tag("script", attributes)
+		hw.str(string(body))
+		hw.str(`</script>`)
+		return hw.err
+	})
+}
+
+func (b *Builder) HelpText(name, text string) template.HTML {
+	if text == "" { text = b.help[strings.TrimSuffix(name, "[]")] }
+	if text == "" { return "" }
+	return template.HTML(fmt.Sprintf(`<div class="%s" id="%s">%s</div>`, b.theme.HelpText, template.HTMLEscapeString(b.helpID(name)), template.HTMLEscapeString(b.translate(text))))
 }
 
 func (b *Builder) FieldError(name string) template.HTML {
-	if msg, ok := b.errors[name]; ok {
-		return template.HTML(fmt.Sprintf(`<div class="invalid-feedback d-block">%s</div>`, msg))
+	if msgs := b.errorMessages(name); len(msgs) > 0 {
+		return template.HTML(b.feedback(b.theme.ErrorMessageClass, b.errorID(name), msgs[0]))
 	}
 	return ""
-}
\ No newline at end of file
+}
+
+func (b *Builder) ValidFeedback(name, message string) template.HTML {
+	if !b.isValid(name) { return "" }
+	return template.HTML(fmt.Sprintf(`<div class="%s">%s</div>`, b.theme.ValidFeedbackClass, template.HTMLEscapeString(b.translate(message))))
+}
+
+func (b *Builder) Errors(name string) template.HTML {
+	var html strings.Builder
+	for i, msg := range b.errorMessages(name) {
+		id := ""
+		if i == 0 { id = b.errorID(name) }
+		html.WriteString(b.feedback(b.theme.ErrorMessageClass, id, msg))
+	}
+	return template.HTML(html.String())
+}
+
+// feedback, hata mesajını temanın ErrorFeedbackTag etiketiyle (varsayılan div) yazar; id boşsa yazılmaz.
+func (b *Builder) feedback(class, id, msg string) string {
+	tag := b.theme.ErrorFeedbackTag
+	if tag == "" { tag = "div" }
+	attributes := map[string]string{"class": class}
+	if id != "" { attributes["id"] = id }
+	return "<" + tag + " " + buildAttributes(attributes) + ">" + template.HTMLEscapeString(msg) + "</" + tag + ">"
+}
+
+// ErrorSummary, form düzeyindeki hataları ve ardından alan hatalarını alan adı sırasıyla tek bir uyarı kutusunda listeler.
+// Hiç hata yoksa boş döner.
+func (b *Builder) ErrorSummary() template.HTML {
+	var msgs []string
+	for _, msg := range b.globalErrors {
+		if b.translateErrors { msg = b.translate(msg) }
+		msgs = append(msgs, msg)
+	}
+	keys := make([]string, 0, len(b.errors)+len(b.fieldErrors))
+	for key := range b.errors { keys = append(keys, key) }
+	for key := range b.fieldErrors {
+		if _, ok := b.errors[key]; !ok { keys = append(keys, key) }
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fieldMsgs := b.fieldErrors[key]
+		if len(fieldMsgs) == 0 {
+			if msg, ok := b.errors[key]; ok { fieldMsgs = []string{msg} }
+		}
+		for _, msg := range fieldMsgs {
+			if b.translateErrors { msg = b.translate(msg) }
+			msgs = append(msgs, msg)
+		}
+	}
+	if len(msgs) == 0 { return "" }
+	var html strings.Builder
+	html.WriteString(fmt.Sprintf(`<div class="%s" role="alert"><ul>`, b.theme.ErrorSummary))
+	for _, msg := range msgs {
+		html.WriteString("<li>" + template.HTMLEscapeString(msg) + "</li>")
+	}
+	html.WriteString(`</ul></div>`)
+	return template.HTML(html.String())
+}
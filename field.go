@@ -0,0 +1,76 @@
+package builder
+
+import "html/template"
+
+// Field, tek bir alanı zincirleme çağrılarla yapılandırmaya yarar. Değer ve hata durumu
+// Render anında bağlı olduğu Builder'dan çözülür.
+type Field struct {
+	builder *Builder
+	name    string
+	typ     string
+	label   string
+	help    string
+	attrs   Attr
+	group   Attr
+}
+
+// Field, verilen alan için zincirlenebilir bir yapılandırıcı döndürür. Varsayılan tip text'tir.
+func (b *Builder) Field(name string) *Field {
+	return &Field{builder: b, name: name, typ: "text", attrs: Attr{}}
+}
+
+// Type, input tipini belirler; "textarea" verilirse Textarea render edilir.
+func (f *Field) Type(typ string) *Field {
+	f.typ = typ
+	return f
+}
+
+// Label, alanı Group içinde bu etiketle render eder.
+func (f *Field) Label(text string) *Field {
+	f.label = text
+	return f
+}
+
+func (f *Field) Placeholder(text string) *Field { return f.Attr("placeholder", text) }
+
+func (f *Field) Required() *Field { return f.Attr("required", "") }
+
+// GroupClass, Label ile kullanıldığında sarmalayıcı div'in sınıfını bu alan için değiştirir; boş verilirse div yazılmaz.
+func (f *Field) GroupClass(class string) *Field {
+	f.group = Attr{"class": class}
+	return f
+}
+
+// Help, alanın altına yardım metni ekler ve input'u aria-describedby ile ona bağlar.
+func (f *Field) Help(text string) *Field {
+	f.help = text
+	return f
+}
+
+// Attr, render edilecek elemana bir nitelik ekler.
+func (f *Field) Attr(key, value string) *Field {
+	f.attrs[key] = value
+	return f
+}
+
+// Render, alanı yapılandırmaya göre üretir.
+func (f *Field) Render() template.HTML {
+	b := f.builder
+	attrs := mergeAttributes(f.attrs)
+	if f.help != "" {
+		attrs["aria-describedby"] = b.helpID(f.name)
+	}
+	var input template.HTML
+	if f.typ == "textarea" {
+		input = b.Textarea(f.name, 0, 0, attrs)
+	} else {
+		input = b.Input(f.typ, f.name, attrs)
+	}
+	if f.help != "" {
+		input += b.HelpText(f.name, f.help)
+	}
+	if f.label != "" {
+		return b.Group(f.name, f.label, input, f.group)
+	}
+	return input + b.FieldError(f.name)
+}
@@ -1,22 +1,47 @@
 package builder
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"html/template"
+	"io"
+	"net/url"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
 )
 
-type Option struct{ Value, Text string }
+// Attr, elemanlara eklenecek ek HTML niteliklerini taşır. Boolean nitelikler boş değerle verildiğinde yalın yazılır.
+type Attr = map[string]string
+
+// Option, tek bir select seçeneğidir. Disabled seçenekler seçilemez ve bağlı değer eşleşse bile seçili işaretlenmez.
+// Group doluysa art arda gelen aynı gruplu seçenekler o etiketle bir <optgroup> içinde yazılır.
+type Option struct {
+	Value, Text string
+	Disabled    bool
+	Group       string
+}
 type Optgroup struct{ Label string; Options []Option }
+type OptGroup = Optgroup
+
+// AttrOption, data-* gibi ek nitelikler taşıyan bir seçenektir; Select'e []AttrOption olarak verilir.
+// Attrs içinde disabled varsa seçenek seçili işaretlenmez.
+type AttrOption struct {
+	Value, Text string
+	Attrs       Attr
+}
 
 func (b *Builder) resolveValue(name string) interface{} {
 	cleanName := strings.TrimSuffix(name, "[]")
 	if b.oldInput != nil {
-		if val, ok := b.oldInput[cleanName]; ok && len(val) > 0 {
-			if len(val) == 1 { return val[0] }
-			return val
+		for _, key := range []string{cleanName, cleanName + "[]"} {
+			if val, ok := b.oldInput[key]; ok && len(val) > 0 {
+				if len(val) == 1 { return val[0] }
+				return val
+			}
 		}
 	}
 	if b.model != nil {
@@ -25,6 +50,19 @@ func (b *Builder) resolveValue(name string) interface{} {
 	return nil
 }
 
+// checkedValue, onay kutularının işaretli durumunu çözer. Anahtar eski girdide varsa esas alınır; gizli eşlik
+// alanından gelen boş değer işaretsiz demektir. Anahtar hiç yoksa model değerine düşülür.
+func (b *Builder) checkedValue(name string) interface{} {
+	cleanName := strings.TrimSuffix(name, "[]")
+	for _, key := range []string{cleanName, cleanName + "[]"} {
+		if val, ok := b.oldInput[key]; ok {
+			if len(val) == 1 { return val[0] }
+			return val
+		}
+	}
+	return b.resolveValue(name)
+}
+
 func (b *Builder) resolveValueAsSlice(name string) []string {
 	value := b.resolveValue(name)
 	if value == nil { return nil }
@@ -32,37 +70,327 @@ func (b *Builder) resolveValueAsSlice(name string) []string {
 	if val.Kind() == reflect.Slice {
 		var result []string
 		for i := 0; i < val.Len(); i++ {
-			result = append(result, fmt.Sprintf("%v", val.Index(i).Interface()))
+			result = append(result, formatValue("", val.Index(i).Interface()))
 		}
 		return result
 	}
-	return []string{fmt.Sprintf("%v", value)}
+	return []string{formatValue("", value)}
+}
+
+var timeLayouts = map[string]string{
+	"date":           "2006-01-02",
+	"time":           "15:04",
+	"datetime-local": "2006-01-02T15:04",
+	"month":          "2006-01",
+}
+
+// formatValue, çözümlenen değeri input tipinin beklediği biçimde metne çevirir.
+func formatValue(typ string, value interface{}) string {
+	if t, ok := value.(time.Time); ok {
+		if t.IsZero() { return "" }
+		// Go'nun layout sisteminde ISO hafta numarası olmadığından week ayrıca biçimlenir.
+		if typ == "week" {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%04d-W%02d", year, week)
+		}
+		if layout, ok := timeLayouts[typ]; ok { return t.Format(layout) }
+	}
+	if f, ok := floatValue(value); ok { return formatFloat(f, -1) }
+	switch f := value.(type) {
+	// Ay ve gün adları String ile İngilizce yazılacağından MonthOptions/WeekdayOptions değerleriyle eşleşmesi için sayı kullanılır.
+	case time.Month: return strconv.Itoa(int(f))
+	case time.Weekday: return strconv.Itoa(int(f))
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// floatValue, float32 ve float64 türündeki (adlandırılmış türler dahil) değerleri float64'e çevirir. float32 değerler
+// en kısa onluk gösterimleri üzerinden çevrilir; böylece 0.1 değeri 0.10000000149 olarak yazılmaz.
+func floatValue(value interface{}) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Float64: return v.Float(), true
+	case reflect.Float32:
+		f, _ := strconv.ParseFloat(strconv.FormatFloat(v.Float(), 'g', -1, 32), 64)
+		return f, true
+	}
+	return 0, false
+}
+
+// formatFloat, ondalık sayıları bilimsel gösterim olmadan yazar. precision negatifse 15 anlamlı basamağa yuvarlanıp
+// en kısa gösterimle yazılır; böylece 0.1+0.2 gibi değerler 0.30000000000000004 yerine 0.3 olarak görünür.
+func formatFloat(f float64, precision int) string {
+	if precision >= 0 { return strconv.FormatFloat(f, 'f', precision, 64) }
+	f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'g', 15, 64), 64)
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if s == "-0" { return "0" }
+	return s
+}
+
+// normalizeColor, değeri color input'unun kabul ettiği #rrggbb biçimine getirir; geçersiz değerler #000000 olur.
+func normalizeColor(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if len(value) == 4 && value[0] == '#' {
+		value = string([]byte{'#', value[1], value[1], value[2], value[2], value[3], value[3]})
+	}
+	if len(value) != 7 || value[0] != '#' { return "#000000" }
+	for _, c := range value[1:] {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') { return "#000000" }
+	}
+	return value
+}
+
+var placeholderTypes = map[string]bool{
+	"text": true, "email": true, "password": true, "url": true, "number": true, "tel": true, "search": true,
+}
+
+// humanize, "first_name" veya "firstName" gibi alan adlarını "First Name" biçimine çevirir.
+func humanize(name string) string {
+	name = strings.TrimSuffix(name, "[]")
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, strings.ToUpper(string(word[:1]))+string(word[1:]))
+			word = nil
+		}
+	}
+	for i, r := range name {
+		switch {
+		case r == '_' || r == '-' || r == '.' || r == ' ':
+			flush()
+		case unicode.IsUpper(r) && i > 0:
+			flush()
+			word = append(word, unicode.ToLower(r))
+		default:
+			word = append(word, r)
+		}
+	}
+	flush()
+	return strings.Join(words, " ")
 }
 
 func getFieldFromModel(model interface{}, fieldName string) interface{} {
+	fieldVal, _, ok := findModelField(model, fieldName)
+	if !ok || !fieldVal.CanInterface() { return nil }
+	return unwrapValue(fieldVal.Interface())
+}
+
+// unwrapValue, pointer ve sql.Null* gibi driver.Valuer tiplerini taşıdıkları değere indirger; nil ya da geçersiz değerler nil olur.
+func unwrapValue(value interface{}) interface{} {
+	for {
+		if valuer, ok := value.(driver.Valuer); ok {
+			if val := reflect.ValueOf(value); val.Kind() == reflect.Ptr && val.IsNil() { return nil }
+			v, err := valuer.Value()
+			if err != nil { return nil }
+			return v
+		}
+		val := reflect.ValueOf(value)
+		if val.Kind() != reflect.Ptr { return value }
+		if val.IsNil() { return nil }
+		value = val.Elem().Interface()
+	}
+}
+
+// findModelField, "address.city" gibi noktalı yolları iç içe struct ve pointer'lar üzerinden çözer.
+func findModelField(model interface{}, fieldName string) (reflect.Value, reflect.StructField, bool) {
 	val := reflect.ValueOf(model)
-	if val.Kind() == reflect.Ptr { val = val.Elem() }
-	if !val.IsValid() || val.Kind() != reflect.Struct { return nil }
+	var found reflect.StructField
+	for _, segment := range fieldPath(fieldName) {
+		for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+			if val.IsNil() { return reflect.Value{}, reflect.StructField{}, false }
+			val = val.Elem()
+		}
+		if val.Kind() == reflect.Slice || val.Kind() == reflect.Array {
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= val.Len() { return reflect.Value{}, reflect.StructField{}, false }
+			val = val.Index(i)
+			continue
+		}
+		// map[string]... modellerde (ör. çözülmüş JSON) anahtar alan adıyla birebir eşleşir.
+		if val.Kind() == reflect.Map && val.Type().Key().Kind() == reflect.String {
+			val = val.MapIndex(reflect.ValueOf(segment).Convert(val.Type().Key()))
+			if !val.IsValid() { return reflect.Value{}, reflect.StructField{}, false }
+			found = reflect.StructField{}
+			continue
+		}
+		if !val.IsValid() || val.Kind() != reflect.Struct { return reflect.Value{}, reflect.StructField{}, false }
+		field, ok := matchStructField(val.Type(), segment)
+		if !ok { return reflect.Value{}, reflect.StructField{}, false }
+		fieldVal, err := val.FieldByIndexErr(field.Index)
+		if err != nil { return reflect.Value{}, reflect.StructField{}, false }
+		val, found = fieldVal, field
+	}
+	if val.Kind() == reflect.Interface {
+		if val.IsNil() { return reflect.Value{}, reflect.StructField{}, false }
+		val = val.Elem()
+	}
+	return val, found, true
+}
+
+// fieldPath, "items[0][name]" ve "items.0.name" biçimindeki alan adlarını yol parçalarına ayırır.
+func fieldPath(name string) []string {
+	name = strings.NewReplacer("][", ".", "[", ".", "]", "").Replace(name)
+	return strings.Split(name, ".")
+}
+
+func matchStructField(typ reflect.Type, fieldName string) (reflect.StructField, bool) {
 	normFieldName := strings.ReplaceAll(strings.Title(strings.ReplaceAll(fieldName, "_", " ")), " ", "")
-	for i := 0; i < val.NumField(); i++ {
-		field := val.Type().Field(i)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
 		tag := field.Tag.Get("form")
 		if tag == "" { tag = field.Tag.Get("json") }
-		if strings.Split(tag, ",")[0] == fieldName { return val.Field(i).Interface() }
-		if field.Name == normFieldName { return val.Field(i).Interface() }
+		if strings.Split(tag, ",")[0] == fieldName || field.Name == normFieldName {
+			return field, true
+		}
 	}
-	return nil
+	// Gömülü struct'ların alanları Go'daki gibi üst seviyedeymiş gibi bulunur; doğrudan alanlar önceliklidir.
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		embedded, ok := embeddedStruct(field)
+		if !ok { continue }
+		if inner, ok := matchStructField(embedded, fieldName); ok {
+			inner.Index = append(append([]int(nil), field.Index...), inner.Index...)
+			return inner, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// embeddedStruct, etiketsiz gömülü bir struct alanının (ya da struct pointer'ının) tipini döndürür.
+func embeddedStruct(field reflect.StructField) (reflect.Type, bool) {
+	if !field.Anonymous || field.Tag.Get("form") != "" || field.Tag.Get("json") != "" { return nil, false }
+	typ := field.Type
+	if typ.Kind() == reflect.Ptr { typ = typ.Elem() }
+	if typ.Kind() != reflect.Struct || typ == timeType { return nil, false }
+	return typ, true
+}
+
+// hasValidationRule, modeldeki alanın validate etiketinde verilen kuralın olup olmadığını kontrol eder.
+func (b *Builder) hasValidationRule(name, rule string) bool {
+	for _, r := range b.validationRules(name) {
+		if r.Tag == rule { return true }
+	}
+	return false
+}
+
+func (b *Builder) validationRules(name string) []validationRule {
+	if b.model == nil { return nil }
+	_, field, ok := findModelField(b.model, strings.TrimSuffix(name, "[]"))
+	if !ok { return nil }
+	return parseValidationRules(field.Tag.Get("validate"))
+}
+
+// applyHTML5Validation, HTML5Validation açıksa validate kurallarından türetilen nitelikleri, kullanıcı vermediyse ekler.
+func (b *Builder) applyHTML5Validation(attributes map[string]string, typ, name string) {
+	if typ == "hidden" { return }
+	if b.clientValidation { b.applyClientValidation(attributes, typ, name) }
+	if !b.html5Validation { return }
+	_, disabled := attributes["disabled"]
+	for k, v := range html5Attributes(typ, b.validationRules(name)) {
+		// required, gönderilmeyen devre dışı alanlara ve her kutuyu ayrı ayrı zorunlu kılacağı için onay kutusu gruplarına eklenmez.
+		if k == "required" && (disabled || (typ == "checkbox" && strings.HasSuffix(name, "[]"))) { continue }
+		if _, ok := attributes[k]; !ok { attributes[k] = v }
+	}
+}
+
+// applyClientValidation, validate kurallarını JS doğrulama eklentilerinin okuduğu data-rule-* ve data-msg-*
+// niteliklerine çevirir.
+func (b *Builder) applyClientValidation(attributes map[string]string, typ, name string) {
+	fieldName := strings.TrimSuffix(name, "[]")
+	resolveID := func(goField string) string {
+		if _, field, ok := findModelField(b.model, goField); ok {
+			if tag := strings.Split(field.Tag.Get("form"), ",")[0]; tag != "" && tag != "-" { return b.ID(tag) }
+			return b.ID(field.Name)
+		}
+		return ""
+	}
+	for _, rule := range b.validationRules(name) {
+		for _, cr := range clientRules(typ, rule, resolveID) {
+			if _, ok := attributes["data-rule-"+cr.Name]; ok { continue }
+			attributes["data-rule-"+cr.Name] = cr.Value
+			attributes["data-msg-"+cr.Name] = b.translate(ruleMessage(fieldName, rule.Tag, rule.Param))
+		}
+	}
+}
+
+// applyFormAttribute, Config.FormAttribute açıkken elemana form="FormID" ekler; böylece <form> dışındaki
+// alanlar ve butonlar da forma bağlanır. Elle verilen form niteliği korunur.
+func (b *Builder) applyFormAttribute(attributes map[string]string) map[string]string {
+	if b.formAttribute && b.formID != "" {
+		if _, ok := attributes["form"]; !ok { attributes["form"] = b.formID }
+	}
+	return attributes
 }
 
 func buildAttributes(attrs map[string]string) string {
-	var attributes []string
+	var html strings.Builder
+	writeAttributes(&htmlWriter{w: &html}, attrs)
+	return html.String()
+}
+
+// coreAttributes, her elemanda diğer niteliklerden önce ve bu sırayla yazılır; kalanlar anahtara göre sıralanır.
+// Böylece aynı alan her render'da bayt bayt aynı çıktıyı verir.
+var coreAttributes = []string{"method", "action", "type", "class", "name", "id", "for", "value"}
+
+func attributeRank(key string) int {
+	for i, core := range coreAttributes {
+		if key == core { return i }
+	}
+	return len(coreAttributes)
+}
+
+func writeAttributes(hw *htmlWriter, attrs map[string]string) {
 	keys := make([]string, 0, len(attrs))
-	for k := range attrs { keys = append(keys, k) }
-	sort.Strings(keys)
-	for _, k := range keys {
-		attributes = append(attributes, fmt.Sprintf(`%s="%s"`, k, template.HTMLEscapeString(attrs[k])))
+	for k := range attrs {
+		if !strings.HasPrefix(k, directivePrefix) { keys = append(keys, k) }
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ri, rj := attributeRank(keys[i]), attributeRank(keys[j])
+		if ri != rj { return ri < rj }
+		return keys[i] < keys[j]
+	})
+	for i, k := range keys {
+		if i > 0 { hw.str(" ") }
+		hw.str(k)
+		if attrs[k] == "" && booleanAttributes[k] { continue }
+		hw.str(`="`)
+		hw.str(template.HTMLEscapeString(attrs[k]))
+		hw.str(`"`)
 	}
-	return strings.Join(attributes, " ")
+}
+
+// htmlWriter, ilk yazma hatasını saklayıp sonraki yazmaları atlayan küçük bir sarmalayıcıdır.
+type htmlWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (hw *htmlWriter) str(s string) {
+	if hw.err == nil {
+		_, hw.err = io.WriteString(hw.w, s)
+	}
+}
+
+func (hw *htmlWriter) tag(name string, attrs map[string]string) {
+	hw.str("<")
+	hw.str(name)
+	if len(attrs) > 0 {
+		hw.str(" ")
+		writeAttributes(hw, attrs)
+	}
+	hw.str(">")
+}
+
+func renderHTML(write func(io.Writer) error) template.HTML {
+	var html strings.Builder
+	write(&html)
+	return template.HTML(html.String())
+}
+
+var booleanAttributes = map[string]bool{
+	"async": true, "autofocus": true, "defer": true, "checked": true, "disabled": true, "formnovalidate": true, "hidden": true,
+	"multiple": true, "novalidate": true, "readonly": true, "required": true, "selected": true,
 }
 
 func mergeAttributes(attrs ...map[string]string) map[string]string {
@@ -73,19 +401,192 @@ func mergeAttributes(attrs ...map[string]string) map[string]string {
 	return merged
 }
 
+// applyClass, temanın temel sınıfını ve alanın durum sınıfını kullanıcı sınıflarıyla birleştirir.
+func applyClass(attributes map[string]string, base, state string) {
+	userClass, ok := attributes["class"]
+	if !ok {
+		attributes["class"] = strings.TrimSpace(base + " " + state)
+		return
+	}
+	if hasClasses(userClass, base) { base = "" }
+	attributes["class"] = strings.Join(strings.Fields(userClass+" "+base+" "+state), " ")
+}
+
+func hasClasses(class, want string) bool {
+	have := make(map[string]bool)
+	for _, c := range strings.Fields(class) { have[c] = true }
+	for _, c := range strings.Fields(want) {
+		if !have[c] { return false }
+	}
+	return true
+}
+
+// stateClass, alanın hata durumuna göre eklenecek sınıfı döndürür.
+func (b *Builder) stateClass(name string) string {
+	if b.hasError(name) { return b.theme.ErrorInputClass }
+	if b.isValid(name) { return b.theme.ValidInputClass }
+	return ""
+}
+
+func (b *Builder) isValid(name string) bool {
+	if b.hasError(name) { return false }
+	if b.valid[name] { return true }
+	if b.inferValid {
+		cleanName := strings.TrimSuffix(name, "[]")
+		_, ok := b.oldInput[cleanName]
+		_, okSlice := b.oldInput[cleanName+"[]"]
+		return ok || okSlice
+	}
+	return false
+}
+
+// sanitizeNonce, nonce değeri nitelik içinde güvenle kullanılamıyorsa boş döndürür.
+func sanitizeNonce(nonce string) string {
+	for _, c := range nonce {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.ContainsRune("+/=-_", c)) {
+			return ""
+		}
+	}
+	return nonce
+}
+
+func (b *Builder) helpID(name string) string  { return b.ID(name) + "-help" }
+func (b *Builder) errorID(name string) string { return b.ID(name) + "-error" }
+
+// idReplacer, alan adlarındaki köşeli parantez ve noktaları, ayrıca HTML id'lerinde geçersiz olan boşluk
+// karakterlerini altçizgilere çevirir.
+var idReplacer = strings.NewReplacer("][", "_", "[", "_", "]", "", ".", "_", " ", "_", "\t", "_", "\n", "_", "\r", "_", "\f", "_")
+
+// ID, alan için üretilen id'yi döndürür: Config.IDPrefix + ad; "items[0][name]" gibi adlar "items_0_name" olur.
+// Label for, aria-describedby ve hata/yardım id'leri hep bu değerden türetilir.
+func (b *Builder) ID(name string) string {
+	return b.idPrefix + idReplacer.Replace(strings.TrimSuffix(name, "[]"))
+}
+
+// applyAria, alanı yardım metni ve hata mesajıyla aria-describedby üzerinden ilişkilendirir.
+func (b *Builder) applyAria(attributes map[string]string, name string) {
+	if _, disabled := attributes["disabled"]; disabled { return }
+	described := strings.Fields(attributes["aria-describedby"])
+	add := func(id string) {
+		for _, d := range described {
+			if d == id { return }
+		}
+		described = append(described, id)
+	}
+	if b.help[strings.TrimSuffix(name, "[]")] != "" { add(b.helpID(name)) }
+	if b.hasError(name) {
+		add(b.errorID(name))
+		attributes["aria-invalid"] = "true"
+	}
+	if len(described) > 0 { attributes["aria-describedby"] = strings.Join(described, " ") }
+}
+
+// queryFields, action URL'sindeki sorgu parametrelerini hariç tutulanlar dışında anahtar sırasıyla döndürür.
+func (b *Builder) queryFields() [][2]string {
+	u, err := url.Parse(b.action)
+	if err != nil || u.RawQuery == "" { return nil }
+	query := u.Query()
+	for _, key := range b.excludeQuery { query.Del(key) }
+	keys := make([]string, 0, len(query))
+	for k := range query { keys = append(keys, k) }
+	sort.Strings(keys)
+	var fields [][2]string
+	for _, k := range keys {
+		for _, v := range query[k] { fields = append(fields, [2]string{k, v}) }
+	}
+	return fields
+}
+
+// takeDirective, bir direktifi nitelikler arasından çıkarıp değerini döndürür.
+func takeDirective(attrs map[string]string, key string) (string, bool) {
+	val, ok := attrs[key]
+	delete(attrs, key)
+	return val, ok
+}
+
 func nameOrID(attrs map[string]string, name string) string {
 	if id, ok := attrs["id"]; ok { return id }
 	return name
 }
 
-func (b *Builder) hasError(name string) bool { _, ok := b.errors[name]; return ok }
+func (b *Builder) hasError(name string) bool { return len(b.errorMessages(name)) > 0 }
+
+// errorMessages, alan için gösterilecek tüm hata mesajlarını döndürür.
+func (b *Builder) errorMessages(name string) []string {
+	var msgs []string
+	for _, key := range b.errorKeys(strings.TrimSuffix(name, "[]")) {
+		if msgs = b.fieldErrors[key]; len(msgs) > 0 { break }
+		if msg, ok := b.errors[key]; ok {
+			msgs = []string{msg}
+			break
+		}
+	}
+	if len(msgs) == 0 { return nil }
+	if !b.translateErrors { return msgs }
+	translated := make([]string, len(msgs))
+	for i, msg := range msgs { translated[i] = b.translate(msg) }
+	return translated
+}
+
+// errorKeys, ErrorKeyStyle'a göre hata haritalarında denenecek anahtarları sırayla döndürür.
+func (b *Builder) errorKeys(name string) []string {
+	if b.errorKeyStyle == ErrorKeyTag { return []string{name} }
+	field, ok := b.goFieldPath(name)
+	switch {
+	case !ok: return []string{name}
+	case b.errorKeyStyle == ErrorKeyField: return []string{field}
+	case field == name: return []string{name}
+	}
+	return []string{name, field}
+}
+
+// goFieldPath, form adını modeldeki Go alan adlarından oluşan yola çevirir ("address.city" -> "Address.City", "items[0][name]" -> "Items[0].Name").
+func (b *Builder) goFieldPath(name string) (string, bool) {
+	if b.model == nil { return "", false }
+	segments := fieldPath(name)
+	var path strings.Builder
+	for i, segment := range segments {
+		_, field, ok := findModelField(b.model, strings.Join(segments[:i+1], "."))
+		if !ok { return "", false }
+		if _, err := strconv.Atoi(segment); err == nil && i > 0 {
+			path.WriteString("[" + segment + "]")
+			continue
+		}
+		if i > 0 { path.WriteString(".") }
+		path.WriteString(field.Name)
+	}
+	return path.String(), true
+}
+
+// translate, Translator tanımlıysa metni çevirir; çeviri bulunamazsa metnin kendisini döndürür.
+func (b *Builder) translate(text string) string {
+	if text == "" { return text }
+	if b.ctxTranslator != nil {
+		if translated := b.ctxTranslator(b.Context(), text); translated != "" { return translated }
+		return text
+	}
+	if b.translator == nil { return text }
+	if translated := b.translator(text); translated != "" { return translated }
+	return text
+}
+
+// truthyValues ve falsyValues, bool alanlarla eşleştirilirken eşdeğer sayılan metin değerleridir.
+var truthyValues = map[string]bool{"1": true, "on": true, "true": true, "yes": true}
+var falsyValues = map[string]bool{"0": true, "off": true, "false": true, "no": true}
 
 func isChecked(selectedValue interface{}, optionValue string) bool {
 	if selectedValue == nil { return false }
+	option := strings.ToLower(optionValue)
+	// Değeri "0"/"false" olan bir kutu ters bağlanır ve bool alan false iken işaretli olur.
+	if checked, ok := selectedValue.(bool); ok { return checked != falsyValues[option] }
+	if s, ok := selectedValue.(string); ok {
+		s = strings.ToLower(s)
+		if (truthyValues[s] && truthyValues[option]) || (falsyValues[s] && falsyValues[option]) { return true }
+	}
 	val := reflect.ValueOf(selectedValue)
 	if val.Kind() == reflect.Slice {
 		for i := 0; i < val.Len(); i++ {
-			if fmt.Sprintf("%v", val.Index(i).Interface()) == optionValue {
+			if isChecked(val.Index(i).Interface(), optionValue) {
 				return true
 			}
 		}
@@ -94,28 +595,73 @@ func isChecked(selectedValue interface{}, optionValue string) bool {
 	return fmt.Sprintf("%v", selectedValue) == optionValue
 }
 
-func buildOptions(options interface{}, selectedValues []string) template.HTML {
-	var html strings.Builder
+// disabledOptionValues, Option.Disabled ile işaretlenmiş seçeneklerin değerlerini toplar; bu değerler seçili sayılmaz.
+func disabledOptionValues(options interface{}) map[string]bool {
+	disabled := make(map[string]bool)
+	collect := func(list []Option) {
+		for _, opt := range list {
+			if opt.Disabled { disabled[opt.Value] = true }
+		}
+	}
+	switch opts := options.(type) {
+	case []Option: collect(opts)
+	case []Optgroup:
+		for _, group := range opts { collect(group.Options) }
+	}
+	return disabled
+}
+
+// writeOptions, seçenekleri yazar. disabled içindeki değerler seçilemez olarak işaretlenir ve seçili sayılmaz.
+func writeOptions(html *htmlWriter, options interface{}, selectedValues []string, disabled map[string]bool) {
 	selectedMap := make(map[string]bool)
 	for _, s := range selectedValues { selectedMap[s] = true }
-	isSelected := func(val string) string {
+	isSelected := func(val string, optDisabled bool) string {
+		if optDisabled || disabled[val] { return " disabled" }
 		if selectedMap[val] { return " selected" }
 		return ""
 	}
+	option := func(opt Option) string {
+		return fmt.Sprintf(`<option value="%s"%s>%s</option>`, template.HTMLEscapeString(opt.Value), isSelected(opt.Value, opt.Disabled), template.HTMLEscapeString(opt.Text))
+	}
+	writeList := func(list []Option) {
+		group := ""
+		for _, opt := range list {
+			if opt.Group != group {
+				if group != "" { html.str(`</optgroup>`) }
+				if opt.Group != "" { html.str(fmt.Sprintf(`<optgroup label="%s">`, template.HTMLEscapeString(opt.Group))) }
+				group = opt.Group
+			}
+			html.str(option(opt))
+		}
+		if group != "" { html.str(`</optgroup>`) }
+	}
 	switch opts := options.(type) {
 	case []Option:
-		for _, opt := range opts { html.WriteString(fmt.Sprintf(`<option value="%s"%s>%s</option>`, opt.Value, isSelected(opt.Value), opt.Text)) }
+		writeList(opts)
 	case []Optgroup:
 		for _, group := range opts {
-			html.WriteString(fmt.Sprintf(`<optgroup label="%s">`, group.Label))
-			for _, opt := range group.Options { html.WriteString(fmt.Sprintf(`<option value="%s"%s>%s</option>`, opt.Value, isSelected(opt.Value), opt.Text)) }
-			html.WriteString(`</optgroup>`)
+			if group.Label == "" {
+				writeList(group.Options)
+				continue
+			}
+			html.str(fmt.Sprintf(`<optgroup label="%s">`, template.HTMLEscapeString(group.Label)))
+			for _, opt := range group.Options { html.str(option(opt)) }
+			html.str(`</optgroup>`)
+		}
+	case []AttrOption:
+		for _, opt := range opts {
+			attributes := mergeAttributes(opt.Attrs)
+			attributes["value"] = opt.Value
+			if _, ok := attributes["disabled"]; !ok {
+				if state := strings.TrimSpace(isSelected(opt.Value, false)); state != "" { attributes[state] = "" }
+			}
+			html.tag("option", attributes)
+			html.str(template.HTMLEscapeString(opt.Text) + "</option>")
 		}
 	case map[string]string:
 		keys := make([]string, 0, len(opts))
 		for k := range opts { keys = append(keys, k) }
 		sort.Strings(keys)
-		for _, k := range keys { html.WriteString(fmt.Sprintf(`<option value="%s"%s>%s</option>`, k, isSelected(k), opts[k])) }
+		for _, k := range keys { html.str(option(Option{Value: k, Text: opts[k]})) }
 	}
-	return template.HTML(html.String())
 }
\ No newline at end of file
@@ -0,0 +1,26 @@
+package builder
+
+import (
+	"fmt"
+	"html/template"
+	"net/url"
+	"strings"
+)
+
+// Honeypot, insanların göremeyeceği ve boş bırakacağı bir tuzak alanı üretir. Botlar bu alanı genellikle doldurur.
+func (b *Builder) Honeypot(name string) template.HTML {
+	attributes := map[string]string{
+		"type":         "text",
+		"name":         name,
+		"id":           b.ID(name),
+		"value":        "",
+		"autocomplete": "off",
+		"tabindex":     "-1",
+	}
+	return template.HTML(fmt.Sprintf(`<div aria-hidden="true" style="position:absolute;left:-10000px;top:auto;width:1px;height:1px;overflow:hidden"><input %s></div>`, buildAttributes(b.applyFormAttribute(attributes))))
+}
+
+// CheckHoneypot, tuzak alan boş bırakılmışsa true döner. false dönen gönderimler spam olarak reddedilmelidir.
+func CheckHoneypot(values url.Values, name string) bool {
+	return strings.TrimSpace(values.Get(name)) == ""
+}
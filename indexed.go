@@ -0,0 +1,51 @@
+package builder
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Indexed, koleksiyon satırları için köşeli parantezli alan adı üretir: Indexed("items", 0, "name") -> "items[0][name]".
+// Üretilen adlar OldInput'taki aynı anahtarlardan ve modeldeki Items[0].Name alanından çözülür.
+func Indexed(collection string, index int, field string) string {
+	return fmt.Sprintf("%s[%d][%s]", collection, index, field)
+}
+
+// Len, koleksiyonun kaç satırla render edilmesi gerektiğini döndürür. Form gönderildiyse OldInput'taki
+// en büyük indeks, aksi halde modeldeki slice'ın uzunluğu esas alınır.
+func (b *Builder) Len(collection string) int {
+	if len(b.oldInput) > 0 {
+		count := 0
+		prefix := collection + "["
+		for key := range b.oldInput {
+			if !strings.HasPrefix(key, prefix) {
+				continue
+			}
+			rest := key[len(prefix):]
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				continue
+			}
+			if i, err := strconv.Atoi(rest[:end]); err == nil && i >= count {
+				count = i + 1
+			}
+		}
+		return count
+	}
+	if b.model == nil {
+		return 0
+	}
+	val, _, ok := findModelField(b.model, collection)
+	if !ok {
+		return 0
+	}
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		return 0
+	}
+	return val.Len()
+}
@@ -0,0 +1,50 @@
+package builder
+
+import (
+	"sort"
+	"strconv"
+)
+
+// Options, herhangi bir slice'ı verilen değer ve metin fonksiyonlarıyla Select seçeneklerine çevirir.
+func Options[T any](items []T, valueFn func(T) string, textFn func(T) string) []Option {
+	options := make([]Option, 0, len(items))
+	for _, item := range items {
+		options = append(options, Option{Value: valueFn(item), Text: textFn(item)})
+	}
+	return options
+}
+
+// OptionsFromMap, bir haritayı anahtarlarına göre sıralanmış seçeneklere çevirir.
+func OptionsFromMap(m map[string]string) []Option {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	options := make([]Option, 0, len(keys))
+	for _, k := range keys {
+		options = append(options, Option{Value: k, Text: m[k]})
+	}
+	return options
+}
+
+// RangeOptions, start'tan end'e kadar (end dahil) step aralıklı sayı seçenekleri üretir; değer ve metin sayının
+// kendisidir. Azalan aralıklar için step negatif verilir; step sıfırsa yön start ve end'den çıkarılır.
+// step aralığın yönüyle uyuşmuyorsa boş döner.
+func RangeOptions(start, end, step int) []Option {
+	if step == 0 {
+		step = 1
+		if start > end {
+			step = -1
+		}
+	}
+	if (step > 0 && start > end) || (step < 0 && start < end) {
+		return nil
+	}
+	options := make([]Option, 0, (end-start)/step+1)
+	for i := start; (step > 0 && i <= end) || (step < 0 && i >= end); i += step {
+		v := strconv.Itoa(i)
+		options = append(options, Option{Value: v, Text: v})
+	}
+	return options
+}
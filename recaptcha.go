@@ -0,0 +1,79 @@
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RecaptchaResponseField, reCAPTCHA widget'ının çözülen token'ı gönderdiği form alanının adıdır.
+const RecaptchaResponseField = "g-recaptcha-response"
+
+// recaptchaVerifyURL, token'ların doğrulandığı Google uç noktasıdır.
+const recaptchaVerifyURL = "https://www.google.com/recaptcha/api/siteverify"
+
+// recaptchaClient, doğrulama isteklerinde kullanılır; zaman aşımı, yanıt vermeyen uç noktanın isteği asılı
+// bırakmasını önler.
+var recaptchaClient = &http.Client{Timeout: 10 * time.Second}
+
+// Recaptcha, reCAPTCHA v2 widget'ının yerleşeceği div'i üretir. Widget'ı RecaptchaScript ile yüklenen Google
+// script'i doldurur; data-theme gibi widget ayarları nitelik olarak verilebilir.
+func (b *Builder) Recaptcha(siteKey string, attrs ...map[string]string) template.HTML {
+	attributes := mergeAttributes(attrs...)
+	applyClass(attributes, "g-recaptcha", "")
+	attributes["data-sitekey"] = siteKey
+	return renderHTML(func(w io.Writer) error {
+		hw := &htmlWriter{w: w}
+		hw.tag("div", attributes)
+		hw.str("</div>")
+		return hw.err
+	})
+}
+
+// RecaptchaScript, reCAPTCHA script'ini Config.Nonce ile birlikte async ve defer olarak yükler.
+func (b *Builder) RecaptchaScript() template.HTML {
+	return b.Script("", Attr{"src": "https://www.google.com/recaptcha/api.js", "async": "", "defer": ""})
+}
+
+// VerifyRecaptcha, formdan gelen token'ı Google'ın doğrulama uç noktasına sorar. Boş token istek atılmadan
+// geçersiz sayılır; remoteIP boş bırakılabilir. Hata yalnızca istek yapılamadığında ya da yanıt okunamadığında döner.
+func VerifyRecaptcha(ctx context.Context, secret, response, remoteIP string) (bool, error) {
+	return verifyRecaptcha(ctx, recaptchaClient, recaptchaVerifyURL, secret, response, remoteIP)
+}
+
+// verifyRecaptcha, VerifyRecaptcha'nın istemci ve uç noktası dışarıdan verilen hâlidir.
+func verifyRecaptcha(ctx context.Context, client *http.Client, endpoint, secret, response, remoteIP string) (bool, error) {
+	if response == "" {
+		return false, nil
+	}
+	form := url.Values{"secret": {secret}, "response": {response}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("recaptcha: unexpected status %d", resp.StatusCode)
+	}
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, err
+	}
+	return result.Success, nil
+}
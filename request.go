@@ -0,0 +1,40 @@
+package builder
+
+import (
+	"mime"
+	"net/http"
+	"net/url"
+)
+
+// maxMultipartMemory, multipart formlar ayrıştırılırken belleğe alınacak en fazla bayt sayısıdır; net/http ile aynıdır.
+const maxMultipartMemory = 32 << 20
+
+// FromRequest, isteğin sorgu ve gövde değerlerini birleştirip Config.OldInput olarak kullanılabilecek biçimde
+// döndürür. Multipart istekler ParseMultipartForm ile ayrıştırılır; ayrıştırılamayan gövdeler yok sayılır.
+func FromRequest(r *http.Request) url.Values {
+	if isMultipartRequest(r) {
+		r.ParseMultipartForm(maxMultipartMemory)
+	} else {
+		r.ParseForm()
+	}
+	values := make(url.Values, len(r.Form))
+	for k, v := range r.Form {
+		values[k] = append([]string(nil), v...)
+	}
+	return values
+}
+
+// ConfigFromRequest, istekten OldInput ve Multipart değerleri doldurulmuş bir Config döndürür. GET ve HEAD
+// isteklerinde form henüz gönderilmemiş sayıldığından OldInput boş bırakılır; alanlar modelden doldurulur.
+func ConfigFromRequest(r *http.Request, model interface{}) Config {
+	config := Config{Model: model, Multipart: isMultipartRequest(r)}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		config.OldInput = FromRequest(r)
+	}
+	return config
+}
+
+func isMultipartRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "multipart/form-data"
+}
@@ -0,0 +1,33 @@
+package builder
+
+import (
+	"html/template"
+	"strconv"
+)
+
+// stepField, çok adımlı formlarda gönderilen adımın taşındığı gizli alanın adıdır.
+const stepField = "_step"
+
+// Step, çok adımlı (wizard) formlar için geçerli adımı taşıyan gizli _step alanını üretir. total sıfırdan
+// büyükse ilerlemeyi gösteren bir <progress> da eklenir. current sıfır ya da negatifse CurrentStep kullanılır.
+func (b *Builder) Step(current, total int) template.HTML {
+	if current <= 0 {
+		current = b.CurrentStep()
+	}
+	html := b.HiddenValue(stepField, strconv.Itoa(current))
+	if total > 0 {
+		html += b.Progress(stepField+"_progress", float64(total), float64(current))
+	}
+	return html
+}
+
+// CurrentStep, Config.Step verilmişse onu, verilmemişse gönderilen _step değerini döndürür; ikisi de yoksa 1'dir.
+func (b *Builder) CurrentStep() int {
+	if b.step > 0 {
+		return b.step
+	}
+	if n, err := strconv.Atoi(b.oldInput.Get(stepField)); err == nil && n > 0 {
+		return n
+	}
+	return 1
+}
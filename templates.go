@@ -0,0 +1,47 @@
+package builder
+
+import (
+	"html/template"
+	"io"
+	"strings"
+)
+
+// WidgetContext, Config.Templates ile verilen özel şablonlara aktarılan alan durumudur.
+type WidgetContext struct {
+	// Widget, şablonun anahtarıdır: input tipi ("text", "email", "checkbox"...), "textarea", "select" ya da "label".
+	Widget string
+	Name   string
+	ID     string
+	Value  string
+	// Values ve Options yalnızca select için doludur.
+	Values  []string
+	Options interface{}
+	// Text, label şablonunda çevrilmiş etiket metnidir.
+	Text     string
+	Required bool
+	HasError bool
+	Error    string
+	// Attrs, builder'ın hesapladığı nitelikler; Attributes aynı niteliklerin etikete doğrudan yazılabilen halidir.
+	Attrs      map[string]string
+	Attributes template.HTMLAttr
+}
+
+// writeWidget, widget için özel şablon tanımlıysa onu çalıştırır; tanımlı değilse false döner ve
+// çağıran yerleşik HTML'i üretir.
+func (b *Builder) writeWidget(w io.Writer, ctx WidgetContext) (bool, error) {
+	tmpl := b.templates[ctx.Widget]
+	if tmpl == nil {
+		return false, nil
+	}
+	attrs := make(map[string]string, len(ctx.Attrs))
+	for k, v := range ctx.Attrs {
+		if !strings.HasPrefix(k, directivePrefix) {
+			attrs[k] = v
+		}
+	}
+	ctx.Attrs = attrs
+	ctx.Attributes = template.HTMLAttr(buildAttributes(attrs))
+	ctx.HasError = b.hasError(ctx.Name)
+	ctx.Error = b.Error(ctx.Name)
+	return true, tmpl.Execute(w, ctx)
+}
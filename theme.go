@@ -0,0 +1,108 @@
+package builder
+
+// Theme, render metodlarının kullandığı CSS sınıflarını taşır. Config.Theme ile değiştirilebilir.
+// ErrorFeedbackTag, hata mesajlarını saran etikettir; boşsa div kullanılır.
+// Horizontal* alanları LayoutHorizontal'da, Inline* alanları LayoutInline'da Group ve Label yerine kullanılır.
+type Theme struct {
+	Input              string
+	Select             string
+	Range              string
+	Check              string
+	CheckWrapper       string
+	CheckLabel         string
+	CheckInline        string
+	Switch             string
+	Floating           string
+	FloatingLabel      string
+	Label              string
+	Group              string
+	HorizontalGroup    string
+	HorizontalLabel    string
+	HorizontalInput    string
+	InlineForm         string
+	InlineGroup        string
+	InlineLabel        string
+	InputGroup         string
+	InputGroupText     string
+	RequiredMark       string
+	HelpText           string
+	ErrorInputClass    string
+	ErrorFeedbackClass string
+	ErrorMessageClass  string
+	ErrorFeedbackTag   string
+	ValidInputClass    string
+	ValidFeedbackClass string
+	ErrorSummary       string
+	SubmitButton       string
+	Button             string
+}
+
+// BootstrapTheme, varsayılan Bootstrap 5 sınıflarıdır.
+var BootstrapTheme = Theme{
+	Input:              "form-control",
+	Select:             "form-select",
+	Range:              "form-range",
+	Check:              "form-check-input",
+	CheckWrapper:       "form-check",
+	CheckLabel:         "form-check-label",
+	CheckInline:        "form-check-inline",
+	Switch:             "form-check form-switch",
+	Floating:           "form-floating mb-3",
+	FloatingLabel:      "",
+	Label:              "form-label",
+	Group:              "form-group mb-3",
+	HorizontalGroup:    "row mb-3",
+	HorizontalLabel:    "col-sm-3 col-form-label",
+	HorizontalInput:    "col-sm-9",
+	InlineForm:         "row row-cols-lg-auto g-3 align-items-center",
+	InlineGroup:        "col-12",
+	InlineLabel:        "visually-hidden",
+	InputGroup:         "input-group",
+	InputGroupText:     "input-group-text",
+	RequiredMark:       "text-danger",
+	HelpText:           "form-text",
+	ErrorInputClass:    "is-invalid",
+	ErrorFeedbackClass: "invalid-feedback",
+	ErrorMessageClass:  "invalid-feedback d-block",
+	ErrorFeedbackTag:   "div",
+	ValidInputClass:    "is-valid",
+	ValidFeedbackClass: "valid-feedback d-block",
+	ErrorSummary:       "alert alert-danger",
+	SubmitButton:       "btn btn-primary",
+	Button:             "btn btn-secondary",
+}
+
+// TailwindTheme, Tailwind CSS yardımcı sınıflarıyla hazırlanmış bir temadır.
+var TailwindTheme = Theme{
+	Input:              "block w-full rounded-md border border-gray-300 px-3 py-2 shadow-sm",
+	Select:             "block w-full rounded-md border border-gray-300 px-3 py-2 shadow-sm",
+	Range:              "w-full",
+	Check:              "h-4 w-4 rounded border-gray-300",
+	CheckWrapper:       "flex items-center gap-2",
+	CheckLabel:         "text-sm text-gray-700",
+	CheckInline:        "inline-flex mr-4",
+	Switch:             "flex items-center gap-2",
+	Floating:           "relative mb-4",
+	FloatingLabel:      "absolute left-3 top-0 -translate-y-1/2 bg-white px-1 text-xs text-gray-500",
+	Label:              "mb-1 block text-sm font-medium text-gray-700",
+	Group:              "mb-4",
+	HorizontalGroup:    "mb-4 grid grid-cols-12 items-center gap-4",
+	HorizontalLabel:    "col-span-3 text-sm font-medium text-gray-700",
+	HorizontalInput:    "col-span-9",
+	InlineForm:         "flex flex-wrap items-end gap-4",
+	InlineGroup:        "",
+	InlineLabel:        "sr-only",
+	InputGroup:         "flex",
+	InputGroupText:     "inline-flex items-center border border-gray-300 bg-gray-50 px-3",
+	RequiredMark:       "text-red-600",
+	HelpText:           "mt-1 text-sm text-gray-500",
+	ErrorInputClass:    "border-red-500",
+	ErrorFeedbackClass: "mt-1 text-sm text-red-600",
+	ErrorMessageClass:  "mt-1 text-sm text-red-600",
+	ErrorFeedbackTag:   "div",
+	ValidInputClass:    "border-green-500",
+	ValidFeedbackClass: "mt-1 text-sm text-green-600",
+	ErrorSummary:       "mb-4 rounded-md border border-red-200 bg-red-50 p-4 text-sm text-red-700",
+	SubmitButton:       "rounded-md bg-indigo-600 px-4 py-2 text-white",
+	Button:             "rounded-md bg-gray-200 px-4 py-2 text-gray-800",
+}
@@ -30,14 +30,85 @@ func Validate(s interface{}) (map[string]string, error) {
 	return errorMap, err
 }
 
-func formatErrorMessage(e validator.FieldError) string {
-	fieldName := e.Field()
-	switch e.Tag() {
+func formatErrorMessage(e validator.FieldError) string { return ruleMessage(e.Field(), e.Tag(), e.Param()) }
+
+// ruleMessage, bir validate kuralı için kullanıcıya gösterilecek mesajı üretir. Sunucu tarafı hatalar ve
+// ClientValidation'ın data-msg-* nitelikleri aynı metinleri kullanır.
+func ruleMessage(fieldName, tag, param string) string {
+	switch tag {
 	case "required": return fmt.Sprintf("The %s field is required.", fieldName)
 	case "email": return "Please provide a valid email address."
-	case "min": return fmt.Sprintf("The %s field must be at least %s characters long.", fieldName, e.Param())
-	case "max": return fmt.Sprintf("The %s field must be at most %s characters long.", fieldName, e.Param())
-	case "eqfield": return fmt.Sprintf("The %s field must match the %s field.", fieldName, e.Param())
+	case "min": return fmt.Sprintf("The %s field must be at least %s characters long.", fieldName, param)
+	case "max": return fmt.Sprintf("The %s field must be at most %s characters long.", fieldName, param)
+	case "eqfield": return fmt.Sprintf("The %s field must match the %s field.", fieldName, param)
 	default: return fmt.Sprintf("The %s field is not valid.", fieldName)
 	}
-}
\ No newline at end of file
+}
+
+type validationRule struct{ Tag, Param string }
+
+// parseValidationRules, "required,min=3,max=20" gibi bir validate etiketini kurallara ayırır.
+// "dive" sonrası kurallar slice elemanlarına ait olduğundan ve "|" içeren alternatifler tek bir kurala indirgenemediğinden atlanır.
+func parseValidationRules(tag string) []validationRule {
+	var rules []validationRule
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "dive" { break }
+		if part == "" || strings.Contains(part, "|") { continue }
+		kv := strings.SplitN(part, "=", 2)
+		rule := validationRule{Tag: kv[0]}
+		if len(kv) == 2 { rule.Param = kv[1] }
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+var lengthInputTypes = map[string]bool{
+	"text": true, "email": true, "password": true, "url": true, "tel": true, "search": true, "textarea": true,
+}
+
+// html5Attributes, validate kurallarını tarayıcının anladığı HTML5 niteliklerine çevirir.
+func html5Attributes(typ string, rules []validationRule) map[string]string {
+	attrs := make(map[string]string)
+	numeric := typ == "number" || typ == "range"
+	for _, rule := range rules {
+		switch {
+		case numeric && (rule.Tag == "min" || rule.Tag == "gte"):
+			attrs["min"] = rule.Param
+		case numeric && (rule.Tag == "max" || rule.Tag == "lte"):
+			attrs["max"] = rule.Param
+		case lengthInputTypes[typ] && rule.Tag == "min":
+			attrs["minlength"] = rule.Param
+		case lengthInputTypes[typ] && rule.Tag == "max":
+			attrs["maxlength"] = rule.Param
+		case lengthInputTypes[typ] && rule.Tag == "len":
+			attrs["minlength"], attrs["maxlength"] = rule.Param, rule.Param
+		case rule.Tag == "required":
+			attrs["required"] = ""
+		}
+	}
+	return attrs
+}
+
+// clientRule, bir validate kuralının jQuery Validate/Parsley tarzı data-rule-* karşılığıdır.
+type clientRule struct{ Name, Value string }
+
+// clientRules, validate kurallarını input tipine göre istemci tarafı kurallara çevirir. resolveID, eqfield
+// kuralındaki Go alan adını karşılaştırılacak input'un id'sine çevirir.
+func clientRules(typ string, rule validationRule, resolveID func(string) string) []clientRule {
+	numeric := typ == "number" || typ == "range"
+	text := lengthInputTypes[typ]
+	switch {
+	case rule.Tag == "required": return []clientRule{{"required", "true"}}
+	case rule.Tag == "email" || rule.Tag == "url": return []clientRule{{rule.Tag, "true"}}
+	case rule.Tag == "numeric" || rule.Tag == "number": return []clientRule{{"number", "true"}}
+	case numeric && (rule.Tag == "min" || rule.Tag == "gte"): return []clientRule{{"min", rule.Param}}
+	case numeric && (rule.Tag == "max" || rule.Tag == "lte"): return []clientRule{{"max", rule.Param}}
+	case text && rule.Tag == "min": return []clientRule{{"minlength", rule.Param}}
+	case text && rule.Tag == "max": return []clientRule{{"maxlength", rule.Param}}
+	case text && rule.Tag == "len": return []clientRule{{"minlength", rule.Param}, {"maxlength", rule.Param}}
+	case rule.Tag == "eqfield":
+		if id := resolveID(rule.Param); id != "" { return []clientRule{{"equalto", "#" + id}} }
+	}
+	return nil
+}
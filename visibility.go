@@ -0,0 +1,46 @@
+package builder
+
+import (
+	"encoding/json"
+	"html/template"
+)
+
+// ShowIf, elemanın yalnızca field alanının değeri values'dan biri olduğunda görünmesi için data-show-if ve
+// data-show-values niteliklerini döndürür. Group'un son argümanı olarak verildiğinde etiket ve hata mesajıyla
+// birlikte tüm sarmalayıcı gizlenir. Nitelikleri VisibilityScript ya da kendi JS kodunuz işler.
+func ShowIf(field string, values ...string) Attr {
+	return Attr{"data-show-if": field, "data-show-values": jsonStrings(values)}
+}
+
+// HideIf, ShowIf'in tersidir: field alanının değeri values'dan biri olduğunda eleman gizlenir.
+func HideIf(field string, values ...string) Attr {
+	return Attr{"data-hide-if": field, "data-hide-values": jsonStrings(values)}
+}
+
+func jsonStrings(values []string) string {
+	if values == nil {
+		values = []string{}
+	}
+	data, _ := json.Marshal(values)
+	return string(data)
+}
+
+// visibilityScript, data-show-if ve data-hide-if niteliklerine göre elemanların hidden durumunu günceller.
+// Alan değeri aynı formdaki name ya da name[] adlı elemanlardan okunur; işaretsiz onay kutuları ve radio'lar sayılmaz.
+const visibilityScript = `(function(){` +
+	`function values(el,name){var root=el.form||el.closest("form")||document,out=[];` +
+	`root.querySelectorAll("[name]").forEach(function(f){if(f.name!==name&&f.name!==name+"[]")return;` +
+	`if((f.type==="checkbox"||f.type==="radio")&&!f.checked)return;` +
+	`if(f.multiple){for(var i=0;i<f.options.length;i++){if(f.options[i].selected)out.push(f.options[i].value);}return;}` +
+	`out.push(f.value);});return out;}` +
+	`function matches(el,attr){var want=JSON.parse(el.getAttribute("data-"+attr+"-values")||"[]");` +
+	`return values(el,el.getAttribute("data-"+attr+"-if")).some(function(v){return want.indexOf(v)>=0;});}` +
+	`function update(){document.querySelectorAll("[data-show-if],[data-hide-if]").forEach(function(el){` +
+	`var hide=(el.hasAttribute("data-show-if")&&!matches(el,"show"))||(el.hasAttribute("data-hide-if")&&matches(el,"hide"));` +
+	`el.hidden=hide;});}` +
+	`document.addEventListener("change",update);document.addEventListener("input",update);` +
+	`if(document.readyState==="loading"){document.addEventListener("DOMContentLoaded",update);}else{update();}})();`
+
+// VisibilityScript, ShowIf ve HideIf ile işaretlenmiş elemanları alan değerleri değiştikçe gösterip gizleyen
+// küçük bir script üretir. Sayfada bir kez, formdan sonra yazılması yeterlidir; Config.Nonce ile CSP uyumludur.
+func (b *Builder) VisibilityScript() template.HTML { return b.Script(template.JS(visibilityScript)) }